@@ -4,9 +4,13 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
+	"tic-tac-go/internal/ai"
+	"tic-tac-go/internal/bot"
 	httpserver "tic-tac-go/internal/http"
+	"tic-tac-go/internal/service"
 )
 
 // main is the entrypoint for the Tic-Tac-Go server application.
@@ -18,7 +22,55 @@ func main() {
 		port = "8080"
 	}
 
-	router := httpserver.NewRouter()
+	// The MENACE model is kept in memory unless a model file is configured.
+	menace := ai.NewMenace()
+	if path := os.Getenv("TICTACGO_MENACE_MODEL"); path != "" {
+		var err error
+		if menace, err = ai.LoadMenace(path); err != nil {
+			log.Fatalf("loading MENACE model failed: %v", err)
+		}
+	}
+	learnFromHumans := os.Getenv("TICTACGO_MENACE_LEARN") == "true"
+
+	// AI moves are played in the background after a short pause.
+	aiMoveDelay := service.DefaultAIMoveDelay
+	if ms := os.Getenv("TICTACGO_AI_DELAY_MS"); ms != "" {
+		n, err := strconv.Atoi(ms)
+		if err != nil || n < 0 {
+			log.Fatalf("invalid TICTACGO_AI_DELAY_MS: %q", ms)
+		}
+		aiMoveDelay = time.Duration(n) * time.Millisecond
+	}
+
+	// External bots forfeit if they do not answer in time.
+	botMoveTimeout := service.DefaultBotMoveTimeout
+	if ms := os.Getenv("TICTACGO_BOT_TIMEOUT_MS"); ms != "" {
+		n, err := strconv.Atoi(ms)
+		if err != nil || n <= 0 {
+			log.Fatalf("invalid TICTACGO_BOT_TIMEOUT_MS: %q", ms)
+		}
+		botMoveTimeout = time.Duration(n) * time.Millisecond
+	}
+
+	// Invite codes of private games expire after a day unless configured.
+	inviteTTL := service.DefaultInviteTTL
+	if min := os.Getenv("TICTACGO_INVITE_TTL_MIN"); min != "" {
+		n, err := strconv.Atoi(min)
+		if err != nil || n <= 0 {
+			log.Fatalf("invalid TICTACGO_INVITE_TTL_MIN: %q", min)
+		}
+		inviteTTL = time.Duration(n) * time.Minute
+	}
+
+	router := httpserver.NewRouterWithConfig(service.GameServiceConfig{
+		Menace:          menace,
+		LearnFromHumans: learnFromHumans,
+		AsyncAI:         true,
+		AIMoveDelay:     aiMoveDelay,
+		BotClient:       bot.NewClient(),
+		BotMoveTimeout:  botMoveTimeout,
+		InviteTTL:       inviteTTL,
+	})
 
 	server := &http.Server{
 		Addr:              ":" + port,
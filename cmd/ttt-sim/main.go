@@ -0,0 +1,213 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// openingPlies is the number of moves that make up an opening.
+const openingPlies = 2
+
+// report summarises a simulation run. Rates are percentages of all games.
+type report struct {
+	X             string         `json:"x"`
+	O             string         `json:"o"`
+	Games         int            `json:"games"`
+	XWins         int            `json:"xWins"`
+	Draws         int            `json:"draws"`
+	OWins         int            `json:"oWins"`
+	XWinRate      float64        `json:"xWinRate"`
+	DrawRate      float64        `json:"drawRate"`
+	OWinRate      float64        `json:"oWinRate"`
+	AverageLength float64        `json:"averageLength"`
+	Openings      []openingStats `json:"openings"`
+}
+
+// openingStats counts the games that started with the same moves.
+type openingStats struct {
+	Moves string `json:"moves"`
+	Games int    `json:"games"`
+	XWins int    `json:"xWins"`
+	Draws int    `json:"draws"`
+	OWins int    `json:"oWins"`
+}
+
+// main plays games between two AI strategies on the classic board without
+// starting the server and prints the results, e.g.
+//
+//	go run ./cmd/ttt-sim -x heuristic -o mcts -games 5000 -format json
+func main() {
+	xName := flag.String("x", "heuristic", "strategy playing X")
+	oName := flag.String("o", "heuristic", "strategy playing O")
+	games := flag.Int("games", 1000, "number of games to play")
+	format := flag.String("format", "text", "output format: text or json")
+	openings := flag.Int("openings", 5, "number of most common openings to report")
+	seed := flag.Int64("seed", 0, "random seed; 0 uses the current time")
+	mctsIterations := flag.Int("mcts-iterations", 500, "MCTS iterations per move")
+	menaceModel := flag.String("menace-model", "", "MENACE model file; empty plays an untrained model")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: ttt-sim [flags]\n\nStrategies: %s\n\n", strings.Join(allStrategyNames(), ", "))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *games < 1 || *openings < 0 || *mctsIterations < 1 || (*format != "text" && *format != "json") {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+
+	opts := playerOptions{
+		mctsIterations: *mctsIterations,
+		menaceModel:    *menaceModel,
+		rng:            rand.New(rand.NewSource(*seed)),
+	}
+	x, err := newPlayer(*xName, opts)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	o, err := newPlayer(*oName, opts)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	r := simulate(x, o, *games, *openings)
+	r.X, r.O = *xName, *oName
+
+	if *format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(r)
+	} else {
+		err = writeText(os.Stdout, r)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// simulate plays the given number of games and reports the results with the
+// topOpenings most common openings.
+func simulate(x, o player, games, topOpenings int) report {
+	r := report{Games: games, Openings: []openingStats{}}
+	byOpening := make(map[string]*openingStats)
+	totalPlies := 0
+
+	for i := 0; i < games; i++ {
+		moves, winner := playGame(x, o)
+		totalPlies += len(moves)
+
+		n := openingPlies
+		if len(moves) < n {
+			n = len(moves)
+		}
+		opening := strings.Join(moves[:n], " ")
+		stats, ok := byOpening[opening]
+		if !ok {
+			stats = &openingStats{Moves: opening}
+			byOpening[opening] = stats
+		}
+		stats.Games++
+
+		switch winner {
+		case models.SymbolX:
+			r.XWins++
+			stats.XWins++
+		case models.SymbolO:
+			r.OWins++
+			stats.OWins++
+		default:
+			r.Draws++
+			stats.Draws++
+		}
+	}
+
+	r.XWinRate = percent(r.XWins, games)
+	r.DrawRate = percent(r.Draws, games)
+	r.OWinRate = percent(r.OWins, games)
+	r.AverageLength = float64(totalPlies) / float64(games)
+
+	for _, stats := range byOpening {
+		r.Openings = append(r.Openings, *stats)
+	}
+	sort.Slice(r.Openings, func(i, j int) bool {
+		if r.Openings[i].Games != r.Openings[j].Games {
+			return r.Openings[i].Games > r.Openings[j].Games
+		}
+		return r.Openings[i].Moves < r.Openings[j].Moves
+	})
+	if len(r.Openings) > topOpenings {
+		r.Openings = r.Openings[:topOpenings]
+	}
+	return r
+}
+
+// playGame plays one classic game and returns its moves in cell notation
+// (e.g. "b2") and the winner (models.SymbolEmpty for a draw).
+func playGame(x, o player) ([]string, models.Symbol) {
+	board := game.NewBoard()
+	toMove := models.SymbolX
+	var moves []string
+
+	for {
+		if winner, isDraw := game.CheckWinner(board); winner != models.SymbolEmpty || isDraw {
+			return moves, winner
+		}
+
+		choose := x
+		if toMove == models.SymbolO {
+			choose = o
+		}
+		row, col := choose(board, toMove)
+		next, err := game.ApplyMove(board, row, col, toMove)
+		if err != nil {
+			// A strategy that cannot move loses the game.
+			return moves, game.OppositeSymbol(toMove)
+		}
+		board = next
+		moves = append(moves, game.FormatCell(row, col))
+		toMove = game.OppositeSymbol(toMove)
+	}
+}
+
+// writeText prints a report as a human-readable table.
+func writeText(w io.Writer, r report) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "X: %s  O: %s  games: %d\n", r.X, r.O, r.Games)
+	fmt.Fprintf(&b, "X wins: %6d (%5.1f%%)\n", r.XWins, r.XWinRate)
+	fmt.Fprintf(&b, "Draws:  %6d (%5.1f%%)\n", r.Draws, r.DrawRate)
+	fmt.Fprintf(&b, "O wins: %6d (%5.1f%%)\n", r.OWins, r.OWinRate)
+	fmt.Fprintf(&b, "Average length: %.2f moves\n", r.AverageLength)
+	if len(r.Openings) > 0 {
+		fmt.Fprintf(&b, "Most common openings:\n")
+		for _, op := range r.Openings {
+			fmt.Fprintf(&b, "  %-6s %6d  X %5.1f%%  draw %5.1f%%  O %5.1f%%\n", op.Moves, op.Games,
+				percent(op.XWins, op.Games), percent(op.Draws, op.Games), percent(op.OWins, op.Games))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// percent returns n as a percentage of total.
+func percent(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) * 100 / float64(total)
+}
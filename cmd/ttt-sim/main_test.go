@@ -0,0 +1,35 @@
+package main
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// runSeeded plays games between two strategies with a fresh rng from seed.
+func runSeeded(t *testing.T, xName, oName string, seed int64) report {
+	t.Helper()
+	opts := playerOptions{mctsIterations: 50, rng: rand.New(rand.NewSource(seed))}
+	x, err := newPlayer(xName, opts)
+	if err != nil {
+		t.Fatalf("newPlayer(%s) error = %v", xName, err)
+	}
+	o, err := newPlayer(oName, opts)
+	if err != nil {
+		t.Fatalf("newPlayer(%s) error = %v", oName, err)
+	}
+	return simulate(x, o, 50, 5)
+}
+
+func TestSimulate_SameSeedSameResults(t *testing.T) {
+	for _, name := range allStrategyNames() {
+		first := runSeeded(t, name, "random", 42)
+		second := runSeeded(t, name, "random", 42)
+		if !reflect.DeepEqual(first, second) {
+			t.Fatalf("%s: expected identical reports for the same seed, got %+v and %+v", name, first, second)
+		}
+		if first.XWins+first.Draws+first.OWins != first.Games {
+			t.Fatalf("%s: results do not add up to %d games: %+v", name, first.Games, first)
+		}
+	}
+}
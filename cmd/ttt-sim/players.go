@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"tic-tac-go/internal/ai"
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// player picks a move for seat on a classic 3x3 board.
+type player func(board models.Board, seat models.Symbol) (row, col int)
+
+// playerOptions configures the strategies that take parameters.
+type playerOptions struct {
+	mctsIterations int
+	menaceModel    string
+	rng            *rand.Rand
+}
+
+// strategyNames lists the strategies newPlayer understands, besides the
+// personality presets.
+var strategyNames = []string{"heuristic", "random", "perfect", "mcts", "menace"}
+
+// newPlayer returns the player for a strategy name: one of strategyNames or
+// a personality preset such as "novice". Names are case-insensitive.
+func newPlayer(name string, opts playerOptions) (player, error) {
+	switch strings.ToLower(name) {
+	case "heuristic":
+		return func(board models.Board, seat models.Symbol) (int, int) {
+			return ai.ChooseMoveWithRand(board, seat, game.OppositeSymbol(seat), false, opts.rng)
+		}, nil
+
+	case "random":
+		return func(board models.Board, seat models.Symbol) (int, int) {
+			moves := game.AvailableMoves(board)
+			move := moves[opts.rng.Intn(len(moves))]
+			return move[0], move[1]
+		}, nil
+
+	case "perfect":
+		return perfectPlayer(ai.NewSolver(), opts.rng), nil
+
+	case "mcts":
+		return func(board models.Board, seat models.Symbol) (int, int) {
+			state := &models.GameState{
+				Variant:     models.GameVariantClassic,
+				Board:       board,
+				CurrentTurn: seat,
+				Status:      models.GameStatusInProgress,
+			}
+			// The iteration budget alone bounds the search, so results
+			// do not depend on the speed of the machine.
+			cfg := ai.MCTSConfig{Iterations: opts.mctsIterations, TimeLimit: time.Hour, Seed: opts.rng.Int63()}
+			move := ai.ChooseMCTSMove(context.Background(), state, seat, cfg)
+			return move.Row, move.Col
+		}, nil
+
+	case "menace":
+		menace := ai.NewMenace()
+		if opts.menaceModel != "" {
+			var err error
+			if menace, err = ai.LoadMenace(opts.menaceModel); err != nil {
+				return nil, err
+			}
+		}
+		return func(board models.Board, seat models.Symbol) (int, int) {
+			return menace.ChooseMoveWithRand(board, opts.rng)
+		}, nil
+	}
+
+	personality, ok := ai.PersonalityPreset(strings.ToUpper(name))
+	if !ok {
+		return nil, fmt.Errorf("unknown strategy %q (want one of %s)", name, strings.Join(allStrategyNames(), ", "))
+	}
+	// Personalities play instantly; think time only matters against humans.
+	return func(board models.Board, seat models.Symbol) (int, int) {
+		return ai.ChooseHumanLikeMove(board, seat, game.OppositeSymbol(seat), false, personality, opts.rng)
+	}, nil
+}
+
+// perfectPlayer plays the best move according to the solver: the fastest
+// win, else a draw, else the slowest loss. Ties are broken at random.
+func perfectPlayer(solver *ai.Solver, rng *rand.Rand) player {
+	rank := map[models.MoveResult]int{
+		models.MoveResultLoss: 0,
+		models.MoveResultDraw: 1,
+		models.MoveResultWin:  2,
+	}
+	return func(board models.Board, seat models.Symbol) (int, int) {
+		analysis, err := solver.Analyze(board, seat)
+		if err != nil || len(analysis.Moves) == 0 {
+			return -1, -1 // should not happen for a valid in-progress game
+		}
+
+		score := func(m models.MoveAnalysis) int {
+			if m.Result == models.MoveResultWin {
+				return rank[m.Result]*100 - m.Distance
+			}
+			return rank[m.Result]*100 + m.Distance
+		}
+		var best []models.MoveAnalysis
+		for _, m := range analysis.Moves {
+			switch {
+			case len(best) == 0 || score(m) > score(best[0]):
+				best = []models.MoveAnalysis{m}
+			case score(m) == score(best[0]):
+				best = append(best, m)
+			}
+		}
+		m := best[rng.Intn(len(best))]
+		return m.Row, m.Col
+	}
+}
+
+// allStrategyNames returns strategyNames followed by the lower-case
+// personality presets.
+func allStrategyNames() []string {
+	names := append([]string(nil), strategyNames...)
+	presets := ai.PersonalityPresetNames()
+	sort.Strings(presets)
+	for _, preset := range presets {
+		names = append(names, strings.ToLower(preset))
+	}
+	return names
+}
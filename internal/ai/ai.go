@@ -19,6 +19,7 @@
 package ai
 
 import (
+	"math/bits"
 	"math/rand"
 	"time"
 
@@ -29,24 +30,34 @@ import (
 // ChooseMove picks the next move for the AI player based on a simple heuristic:
 // 1) win if possible, 2) block opponent, 3) take center, 4) pick a random free cell.
 func ChooseMove(board models.Board, aiSymbol, opponentSymbol models.Symbol) (row, col int) {
+	return ChooseMoveWithTorus(board, aiSymbol, opponentSymbol, false)
+}
+
+// ChooseMoveWithTorus works like ChooseMove; if torus is true, the AI looks for
+// lines that wrap around the edges of the board. The search runs on a bitboard.
+func ChooseMoveWithTorus(board models.Board, aiSymbol, opponentSymbol models.Symbol, torus bool) (row, col int) {
+	return ChooseMoveWithRand(board, aiSymbol, opponentSymbol, torus, nil)
+}
+
+// ChooseMoveWithRand works like ChooseMoveWithTorus but draws the random move
+// from rng, so that a seeded rng replays the same games. A nil rng uses a
+// source seeded with the current time.
+func ChooseMoveWithRand(board models.Board, aiSymbol, opponentSymbol models.Symbol, torus bool, rng *rand.Rand) (row, col int) {
+	b, err := game.FromBoard(board)
+	if err != nil {
+		return -1, -1 // boards holding numbers are handled by ChooseNumericalMove
+	}
+	masks := game.WinMasks(b.Size, b.Size, torus)
+	empty := b.Empty()
+
 	// 1. Try to win.
-	for _, move := range game.AvailableMoves(board) {
-		r, c := move[0], move[1]
-		b, _ := game.ApplyMove(board, r, c, aiSymbol)
-		winner, _ := game.CheckWinner(b)
-		if winner == aiSymbol {
-			return r, c
-		}
+	if bit := completingCell(b.Marks(aiSymbol), empty, masks); bit != 0 {
+		return b.Cell(bit)
 	}
 
 	// 2. Block opponent's winning move.
-	for _, move := range game.AvailableMoves(board) {
-		r, c := move[0], move[1]
-		b, _ := game.ApplyMove(board, r, c, opponentSymbol)
-		winner, _ := game.CheckWinner(b)
-		if winner == opponentSymbol {
-			return r, c
-		}
+	if bit := completingCell(b.Marks(opponentSymbol), empty, masks); bit != 0 {
+		return b.Cell(bit)
 	}
 
 	// 3. Take center if free.
@@ -55,13 +66,28 @@ func ChooseMove(board models.Board, aiSymbol, opponentSymbol models.Symbol) (row
 	}
 
 	// 4. Random available move.
-	moves := game.AvailableMoves(board)
-	if len(moves) == 0 {
+	n := bits.OnesCount64(empty)
+	if n == 0 {
 		return -1, -1 // should not happen for a valid in-progress game
 	}
 
-	// Use a local rand source so tests are deterministic if needed.
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
-	choice := moves[rng.Intn(len(moves))]
-	return choice[0], choice[1]
+	if rng == nil {
+		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	for skip := rng.Intn(n); skip > 0; skip-- {
+		empty &= empty - 1 // drop the lowest empty cell
+	}
+	return b.Cell(empty & -empty)
+}
+
+// completingCell returns the first empty cell (as a single-bit mask, in row-major
+// order) that completes a line for marks, or 0 if there is none.
+func completingCell(marks, empty uint64, masks []uint64) uint64 {
+	for rest := empty; rest != 0; rest &= rest - 1 {
+		bit := rest & -rest
+		if game.HasLine(marks|bit, masks) {
+			return bit
+		}
+	}
+	return 0
 }
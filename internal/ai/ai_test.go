@@ -19,6 +19,7 @@
 package ai
 
 import (
+	"math/rand"
 	"testing"
 
 	"tic-tac-go/internal/game"
@@ -63,3 +64,17 @@ func TestChooseMove_TakesCenterIfFree(t *testing.T) {
 		t.Fatalf("expected AI to take center (1,1), got (%d,%d)", row, col)
 	}
 }
+
+func TestChooseMoveWithRand_IsReproducible(t *testing.T) {
+	board := game.NewBoard()
+	board[1][1] = models.SymbolO
+
+	// Without a win, block or center, the move is drawn from rng.
+	for seed := int64(1); seed <= 10; seed++ {
+		row1, col1 := ChooseMoveWithRand(board, models.SymbolX, models.SymbolO, false, rand.New(rand.NewSource(seed)))
+		row2, col2 := ChooseMoveWithRand(board, models.SymbolX, models.SymbolO, false, rand.New(rand.NewSource(seed)))
+		if row1 != row2 || col1 != col2 {
+			t.Fatalf("seed %d: expected the same move, got (%d,%d) and (%d,%d)", seed, row1, col1, row2, col2)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"testing"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// The board-based reference implementations below mirror the search before it
+// moved to bitboards; the benchmarks compare both.
+
+func chooseMoveBoardReference(board models.Board, aiSymbol, opponentSymbol models.Symbol) (row, col int) {
+	for _, symbol := range []models.Symbol{aiSymbol, opponentSymbol} {
+		for _, move := range game.AvailableMoves(board) {
+			b, _ := game.ApplyMove(board, move[0], move[1], symbol)
+			if winner, _ := game.CheckWinner(b); winner == symbol {
+				return move[0], move[1]
+			}
+		}
+	}
+	if game.IsValidMove(board, 1, 1) {
+		return 1, 1
+	}
+	moves := game.AvailableMoves(board)
+	return moves[0][0], moves[0][1]
+}
+
+func morrisNegamaxBoardReference(board models.Board, toMove models.Symbol, depth int) int {
+	if game.LineWinner(board, len(board), false) != models.SymbolEmpty {
+		return -(morrisWinScore + depth)
+	}
+	moves := game.MorrisMoves(board, toMove)
+	if len(moves) == 0 {
+		return -(morrisWinScore + depth)
+	}
+	if depth == 0 {
+		return 0
+	}
+	best := -morrisWinScore - morrisSearchDepth - 1
+	for _, move := range moves {
+		var next models.Board
+		if move.From != nil {
+			next, _ = game.ApplySlide(board, *move.From, [2]int{move.Row, move.Col}, toMove)
+		} else {
+			next, _ = game.ApplyMove(board, move.Row, move.Col, toMove)
+		}
+		if score := -morrisNegamaxBoardReference(next, game.OppositeSymbol(toMove), depth-1); score > best {
+			best = score
+		}
+	}
+	return best
+}
+
+// benchmarkBoard has no immediate win or block, so both heuristics scan every
+// cell twice before taking the center.
+func benchmarkBoard() models.Board {
+	board := game.NewBoard()
+	board[0][0] = models.SymbolX
+	board[2][1] = models.SymbolO
+	return board
+}
+
+// morrisBenchmarkBoard is a sliding-phase position with no line.
+func morrisBenchmarkBoard() models.Board {
+	board := game.NewBoard()
+	board[0][0], board[0][2], board[2][1] = models.SymbolX, models.SymbolX, models.SymbolX
+	board[0][1], board[2][0], board[2][2] = models.SymbolO, models.SymbolO, models.SymbolO
+	return board
+}
+
+func TestMorrisSearch_MatchesBoardReference(t *testing.T) {
+	board := morrisBenchmarkBoard()
+	b, _ := game.FromBoard(board)
+	s := morrisSearch{masks: game.WinMasks(3, 3, false), adjacency: game.MorrisAdjacencyMasks(3)}
+
+	for depth := 0; depth <= morrisSearchDepth; depth++ {
+		got := s.negamax(b, models.SymbolX, depth)
+		want := morrisNegamaxBoardReference(board, models.SymbolX, depth)
+		if got != want {
+			t.Fatalf("depth %d: bitboard score %d, board score %d", depth, got, want)
+		}
+	}
+}
+
+func BenchmarkChooseMove_Board(b *testing.B) {
+	board := benchmarkBoard()
+	for i := 0; i < b.N; i++ {
+		chooseMoveBoardReference(board, models.SymbolO, models.SymbolX)
+	}
+}
+
+func BenchmarkChooseMove_Bitboard(b *testing.B) {
+	board := benchmarkBoard()
+	for i := 0; i < b.N; i++ {
+		ChooseMove(board, models.SymbolO, models.SymbolX)
+	}
+}
+
+func BenchmarkMorrisSearch_Board(b *testing.B) {
+	board := morrisBenchmarkBoard()
+	for i := 0; i < b.N; i++ {
+		morrisNegamaxBoardReference(board, models.SymbolX, morrisSearchDepth)
+	}
+}
+
+func BenchmarkMorrisSearch_Bitboard(b *testing.B) {
+	board := morrisBenchmarkBoard()
+	for i := 0; i < b.N; i++ {
+		bb, _ := game.FromBoard(board)
+		s := morrisSearch{masks: game.WinMasks(3, 3, false), adjacency: game.MorrisAdjacencyMasks(3)}
+		s.negamax(bb, models.SymbolX, morrisSearchDepth)
+	}
+}
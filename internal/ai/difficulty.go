@@ -0,0 +1,95 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"tic-tac-go/internal/models"
+)
+
+// difficultyLevels are the personalities of the adaptive opponent, from the
+// weakest (level 1) to the strongest.
+var difficultyLevels = []string{"NOVICE", "CASUAL", "AGGRESSIVE", "MACHINE"}
+
+const (
+	// DefaultDifficultyLevel is where new players start
+	DefaultDifficultyLevel = 2
+	// DifficultyStreak is the number of consecutive wins (or losses) that
+	// raises (or lowers) the level by one
+	DifficultyStreak = 2
+)
+
+// MaxDifficultyLevel returns the strongest level of the adaptive opponent.
+func MaxDifficultyLevel() int {
+	return len(difficultyLevels)
+}
+
+// NewDifficulty returns the starting difficulty of a player.
+func NewDifficulty() models.Difficulty {
+	return models.Difficulty{Level: DefaultDifficultyLevel}
+}
+
+// DifficultyPersonality returns the personality the adaptive opponent plays
+// with at the given level. Levels out of range are clamped.
+func DifficultyPersonality(level int) models.Personality {
+	level = clampLevel(level)
+	p, _ := PersonalityPreset(difficultyLevels[level-1])
+	return p
+}
+
+// AdaptDifficulty updates a player's difficulty after a finished game.
+// result is the human's result: a win extends a winning streak, a loss a
+// losing streak and a draw ends any streak. Every DifficultyStreak wins in a
+// row raise the level, every DifficultyStreak losses in a row lower it.
+func AdaptDifficulty(d models.Difficulty, result models.MoveResult) models.Difficulty {
+	d.GamesPlayed++
+	switch result {
+	case models.MoveResultWin:
+		if d.Streak < 0 {
+			d.Streak = 0
+		}
+		d.Streak++
+	case models.MoveResultLoss:
+		if d.Streak > 0 {
+			d.Streak = 0
+		}
+		d.Streak--
+	default:
+		d.Streak = 0
+	}
+
+	switch {
+	case d.Streak >= DifficultyStreak:
+		d.Level, d.Streak = d.Level+1, 0
+	case d.Streak <= -DifficultyStreak:
+		d.Level, d.Streak = d.Level-1, 0
+	}
+	d.Level = clampLevel(d.Level)
+	return d
+}
+
+// clampLevel limits a level to 1..MaxDifficultyLevel.
+func clampLevel(level int) int {
+	if level < 1 {
+		return 1
+	}
+	if level > len(difficultyLevels) {
+		return len(difficultyLevels)
+	}
+	return level
+}
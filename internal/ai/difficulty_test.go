@@ -0,0 +1,80 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"testing"
+
+	"tic-tac-go/internal/models"
+)
+
+func TestAdaptDifficulty_StreaksMoveLevel(t *testing.T) {
+	d := NewDifficulty()
+
+	d = AdaptDifficulty(d, models.MoveResultWin)
+	if d.Level != DefaultDifficultyLevel || d.Streak != 1 {
+		t.Fatalf("one win should only start a streak, got %+v", d)
+	}
+	d = AdaptDifficulty(d, models.MoveResultWin)
+	if d.Level != DefaultDifficultyLevel+1 || d.Streak != 0 {
+		t.Fatalf("two wins should raise the level, got %+v", d)
+	}
+
+	d = AdaptDifficulty(d, models.MoveResultLoss)
+	d = AdaptDifficulty(d, models.MoveResultDraw)
+	d = AdaptDifficulty(d, models.MoveResultLoss)
+	if d.Level != DefaultDifficultyLevel+1 || d.Streak != -1 {
+		t.Fatalf("a draw should reset the losing streak, got %+v", d)
+	}
+	d = AdaptDifficulty(d, models.MoveResultLoss)
+	if d.Level != DefaultDifficultyLevel || d.Streak != 0 {
+		t.Fatalf("two losses should lower the level, got %+v", d)
+	}
+	if d.GamesPlayed != 6 {
+		t.Fatalf("expected 6 games played, got %d", d.GamesPlayed)
+	}
+}
+
+func TestAdaptDifficulty_ClampsLevel(t *testing.T) {
+	d := models.Difficulty{Level: 1}
+	for i := 0; i < 4; i++ {
+		d = AdaptDifficulty(d, models.MoveResultLoss)
+	}
+	if d.Level != 1 {
+		t.Fatalf("expected level to stay at 1, got %d", d.Level)
+	}
+
+	d = models.Difficulty{Level: MaxDifficultyLevel()}
+	for i := 0; i < 4; i++ {
+		d = AdaptDifficulty(d, models.MoveResultWin)
+	}
+	if d.Level != MaxDifficultyLevel() {
+		t.Fatalf("expected level to stay at %d, got %d", MaxDifficultyLevel(), d.Level)
+	}
+}
+
+func TestDifficultyPersonality(t *testing.T) {
+	weakest, strongest := DifficultyPersonality(1), DifficultyPersonality(MaxDifficultyLevel())
+	if weakest.BlunderRate <= strongest.BlunderRate {
+		t.Fatalf("expected level 1 to blunder more than the top level: %+v vs %+v", weakest, strongest)
+	}
+	if p := DifficultyPersonality(99); p.Name != strongest.Name {
+		t.Fatalf("expected out-of-range level to clamp to %s, got %s", strongest.Name, p.Name)
+	}
+}
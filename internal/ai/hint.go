@@ -0,0 +1,116 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// SuggestMove recommends a move for player in a classic game together with a
+// short reason. It follows the well-known strategy order: win, block, fork,
+// block a fork, center, opposite corner, empty corner, empty side.
+func SuggestMove(board models.Board, player, opponent models.Symbol, torus bool) models.Hint {
+	moves := game.AvailableMoves(board)
+
+	// 1. Win now.
+	if cells := winningCells(board, player, torus); len(cells) > 0 {
+		return models.Hint{Row: cells[0][0], Col: cells[0][1], Reason: models.HintReasonWinNow}
+	}
+
+	// 2. Block the opponent's immediate win.
+	if cells := winningCells(board, opponent, torus); len(cells) > 0 {
+		return models.Hint{Row: cells[0][0], Col: cells[0][1], Reason: models.HintReasonBlock}
+	}
+
+	// 3. Fork: create two winning threats at once.
+	if cells := forkCells(board, player, torus); len(cells) > 0 {
+		return models.Hint{Row: cells[0][0], Col: cells[0][1], Reason: models.HintReasonFork}
+	}
+
+	// 4. Block the opponent's fork, preferably by forcing them to defend
+	// somewhere that does not give them the fork.
+	if opponentForks := forkCells(board, opponent, torus); len(opponentForks) > 0 {
+		if len(opponentForks) > 1 {
+			for _, move := range moves {
+				b, _ := game.ApplyMove(board, move[0], move[1], player)
+				threats := winningCells(b, player, torus)
+				if len(threats) != 1 {
+					continue
+				}
+				reply, _ := game.ApplyMove(b, threats[0][0], threats[0][1], opponent)
+				if len(winningCells(reply, opponent, torus)) < 2 {
+					return models.Hint{Row: move[0], Col: move[1], Reason: models.HintReasonBlockFork}
+				}
+			}
+		}
+		return models.Hint{Row: opponentForks[0][0], Col: opponentForks[0][1], Reason: models.HintReasonBlockFork}
+	}
+
+	// 5. Take the center.
+	size := len(board)
+	if size%2 == 1 && game.IsValidMove(board, size/2, size/2) {
+		return models.Hint{Row: size / 2, Col: size / 2, Reason: models.HintReasonTakeCenter}
+	}
+
+	// 6. Take the corner opposite an opponent's corner, 7. any empty corner.
+	last := size - 1
+	corners := [][2]int{{0, 0}, {0, last}, {last, 0}, {last, last}}
+	for _, corner := range corners {
+		if board[corner[0]][corner[1]] == opponent && game.IsValidMove(board, last-corner[0], last-corner[1]) {
+			return models.Hint{Row: last - corner[0], Col: last - corner[1], Reason: models.HintReasonOppositeCorner}
+		}
+	}
+	for _, corner := range corners {
+		if game.IsValidMove(board, corner[0], corner[1]) {
+			return models.Hint{Row: corner[0], Col: corner[1], Reason: models.HintReasonTakeCorner}
+		}
+	}
+
+	// 8. Any remaining cell is a side.
+	if len(moves) == 0 {
+		return models.Hint{Row: -1, Col: -1} // should not happen for a valid in-progress game
+	}
+	return models.Hint{Row: moves[0][0], Col: moves[0][1], Reason: models.HintReasonTakeSide}
+}
+
+// winningCells returns the empty cells where symbol would complete a line.
+func winningCells(board models.Board, symbol models.Symbol, torus bool) [][2]int {
+	var cells [][2]int
+	for _, move := range game.AvailableMoves(board) {
+		b, _ := game.ApplyMove(board, move[0], move[1], symbol)
+		if winner, _ := game.CheckWinnerWithTorus(b, torus); winner == symbol {
+			cells = append(cells, move)
+		}
+	}
+	return cells
+}
+
+// forkCells returns the empty cells where symbol would create two or more
+// winning threats at once.
+func forkCells(board models.Board, symbol models.Symbol, torus bool) [][2]int {
+	var cells [][2]int
+	for _, move := range game.AvailableMoves(board) {
+		b, _ := game.ApplyMove(board, move[0], move[1], symbol)
+		if len(winningCells(b, symbol, torus)) >= 2 {
+			cells = append(cells, move)
+		}
+	}
+	return cells
+}
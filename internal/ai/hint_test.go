@@ -0,0 +1,80 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"testing"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+func TestSuggestMove_Reasons(t *testing.T) {
+	tests := []struct {
+		name    string
+		x, o    [][2]int
+		wantRow int
+		wantCol int
+		reason  models.HintReason
+	}{
+		{"win now", [][2]int{{0, 0}, {0, 1}}, [][2]int{{1, 0}, {1, 1}}, 0, 2, models.HintReasonWinNow},
+		{"block", [][2]int{{0, 0}, {2, 2}}, [][2]int{{1, 0}, {1, 1}}, 1, 2, models.HintReasonBlock},
+		{"fork", [][2]int{{0, 0}, {1, 1}}, [][2]int{{0, 1}, {2, 2}}, 1, 0, models.HintReasonFork},
+		{"take center", [][2]int{{0, 0}}, nil, 1, 1, models.HintReasonTakeCenter},
+		{"opposite corner", [][2]int{{1, 1}}, [][2]int{{0, 0}}, 2, 2, models.HintReasonOppositeCorner},
+		{"take corner", [][2]int{{1, 1}}, nil, 0, 0, models.HintReasonTakeCorner},
+	}
+	for _, tt := range tests {
+		board := game.NewBoard()
+		for _, c := range tt.x {
+			board[c[0]][c[1]] = models.SymbolX
+		}
+		for _, c := range tt.o {
+			board[c[0]][c[1]] = models.SymbolO
+		}
+		toMove := models.SymbolX
+		if len(tt.x) > len(tt.o) {
+			toMove = models.SymbolO
+		}
+
+		hint := SuggestMove(board, toMove, game.OppositeSymbol(toMove), false)
+
+		if hint.Row != tt.wantRow || hint.Col != tt.wantCol || hint.Reason != tt.reason {
+			t.Errorf("%s: expected (%d,%d) %q, got %+v", tt.name, tt.wantRow, tt.wantCol, tt.reason, hint)
+		}
+	}
+}
+
+func TestSuggestMove_BlocksForkByForcingDefence(t *testing.T) {
+	// X holds opposite corners around O's center; O must not take a corner,
+	// it has to create a threat on a side instead.
+	board := game.NewBoard()
+	board[0][0] = models.SymbolX
+	board[1][1] = models.SymbolO
+	board[2][2] = models.SymbolX
+
+	hint := SuggestMove(board, models.SymbolO, models.SymbolX, false)
+
+	if hint.Reason != models.HintReasonBlockFork {
+		t.Fatalf("expected block fork, got %+v", hint)
+	}
+	if (hint.Row == 0 || hint.Row == 2) && (hint.Col == 0 || hint.Col == 2) {
+		t.Fatalf("expected a side cell, got (%d,%d)", hint.Row, hint.Col)
+	}
+}
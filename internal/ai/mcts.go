@@ -0,0 +1,217 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// Default search budget of the MCTS strategy.
+const (
+	DefaultMCTSIterations = 2000
+	DefaultMCTSTimeLimit  = 500 * time.Millisecond
+)
+
+// mctsExploration is the UCT exploration constant.
+const mctsExploration = math.Sqrt2
+
+// mctsMaxRolloutPlies ends a random playout as a draw if it runs this long.
+const mctsMaxRolloutPlies = 200
+
+// MCTSConfig sets the budget of a Monte Carlo Tree Search. The search stops at
+// whichever limit is reached first, or when its context is cancelled.
+// Zero values fall back to the defaults.
+type MCTSConfig struct {
+	Iterations int
+	TimeLimit  time.Duration
+	// Seed makes the search reproducible if non-zero
+	Seed int64
+}
+
+// mctsNode is a node of the search tree: the position after mover played move.
+type mctsNode struct {
+	parent   *mctsNode
+	move     models.Move
+	mover    models.Symbol
+	children []*mctsNode
+	untried  []models.Move
+	visits   int
+	// reward is the sum of playout results from mover's point of view
+	// (1 for a win, 0.5 for a draw, 0 for a loss)
+	reward   float64
+	terminal bool
+	winner   models.Symbol
+}
+
+// ChooseMCTSMove picks a move for the player in seat with Monte Carlo Tree
+// Search (UCT). It works for every variant with full information, using the
+// variant rules from the game package for move generation and outcomes.
+// The search returns the most visited move found so far when ctx is cancelled.
+func ChooseMCTSMove(ctx context.Context, state *models.GameState, seat models.Symbol, cfg MCTSConfig) models.Move {
+	if cfg.Iterations <= 0 {
+		cfg.Iterations = DefaultMCTSIterations
+	}
+	if cfg.TimeLimit <= 0 {
+		cfg.TimeLimit = DefaultMCTSTimeLimit
+	}
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(seed))
+
+	rootState := cloneForSearch(state)
+	rootState.CurrentTurn = seat
+	root := &mctsNode{mover: game.OppositeSymbol(seat), untried: game.LegalMoves(rootState, seat)}
+	if len(root.untried) == 0 {
+		return models.Move{Row: -1, Col: -1} // should not happen for a valid in-progress game
+	}
+
+	deadline := time.Now().Add(cfg.TimeLimit)
+	for i := 0; i < cfg.Iterations; i++ {
+		if ctx.Err() != nil || time.Now().After(deadline) {
+			break
+		}
+		runMCTSIteration(root, cloneForSearch(rootState), rng)
+	}
+
+	if len(root.children) == 0 {
+		return root.untried[rng.Intn(len(root.untried))]
+	}
+	best := root.children[0]
+	for _, child := range root.children[1:] {
+		if child.visits > best.visits {
+			best = child
+		}
+	}
+	return best.move
+}
+
+// runMCTSIteration runs one selection, expansion, playout and backpropagation
+// step on the tree below root; state is a private copy of the root position.
+func runMCTSIteration(root *mctsNode, state *models.GameState, rng *rand.Rand) {
+	node := root
+
+	// Selection: descend through fully expanded nodes.
+	for !node.terminal && len(node.untried) == 0 && len(node.children) > 0 {
+		node = node.selectChild()
+		advanceSearchState(state, node.mover, node.move)
+	}
+
+	// Expansion: add one untried move.
+	if !node.terminal && len(node.untried) > 0 {
+		i := rng.Intn(len(node.untried))
+		move := node.untried[i]
+		node.untried = append(node.untried[:i], node.untried[i+1:]...)
+
+		mover := state.CurrentTurn
+		finished, winner := advanceSearchState(state, mover, move)
+		child := &mctsNode{parent: node, move: move, mover: mover, terminal: finished, winner: winner}
+		if !finished {
+			child.untried = game.LegalMoves(state, state.CurrentTurn)
+		}
+		node.children = append(node.children, child)
+		node = child
+	}
+
+	// Playout: random moves until the game ends.
+	winner := node.winner
+	if !node.terminal {
+		winner = randomPlayout(state, rng)
+	}
+
+	// Backpropagation.
+	for n := node; n != nil; n = n.parent {
+		n.visits++
+		switch winner {
+		case n.mover:
+			n.reward++
+		case models.SymbolEmpty:
+			n.reward += 0.5
+		}
+	}
+}
+
+// selectChild returns the child with the highest UCT value.
+func (n *mctsNode) selectChild() *mctsNode {
+	var best *mctsNode
+	bestValue := math.Inf(-1)
+	logVisits := math.Log(float64(n.visits))
+	for _, child := range n.children {
+		value := child.reward/float64(child.visits) + mctsExploration*math.Sqrt(logVisits/float64(child.visits))
+		if value > bestValue {
+			best, bestValue = child, value
+		}
+	}
+	return best
+}
+
+// randomPlayout plays random legal moves from state until the game ends and
+// returns the winner (models.SymbolEmpty for a draw).
+func randomPlayout(state *models.GameState, rng *rand.Rand) models.Symbol {
+	for ply := 0; ply < mctsMaxRolloutPlies; ply++ {
+		moves := game.LegalMoves(state, state.CurrentTurn)
+		if len(moves) == 0 {
+			return models.SymbolEmpty
+		}
+		if finished, winner := advanceSearchState(state, state.CurrentTurn, moves[rng.Intn(len(moves))]); finished {
+			return winner
+		}
+	}
+	return models.SymbolEmpty
+}
+
+// advanceSearchState plays a legal move on a search copy the same way the
+// game service does and reports whether the game ended, and who won.
+func advanceSearchState(state *models.GameState, seat models.Symbol, move models.Move) (finished bool, winner models.Symbol) {
+	board, err := game.ApplyVariantMove(state, seat, move)
+	if err != nil {
+		return true, game.OppositeSymbol(seat) // cannot happen for moves from game.LegalMoves
+	}
+	state.Board = board
+	if state.Variant == models.GameVariantThreeMensMorris {
+		state.PositionHistory = append(state.PositionHistory, game.PositionKey(board, game.OppositeSymbol(seat)))
+	}
+
+	state.CurrentTurn = seat
+	winner, isDraw := game.Outcome(state)
+	if winner != models.SymbolEmpty || isDraw {
+		return true, winner
+	}
+	state.CurrentTurn = game.OppositeSymbol(seat)
+	return false, models.SymbolEmpty
+}
+
+// cloneForSearch copies the parts of a game state the search modifies.
+func cloneForSearch(state *models.GameState) *models.GameState {
+	return &models.GameState{
+		Variant:         state.Variant,
+		Board:           game.CloneBoard(state.Board),
+		CurrentTurn:     state.CurrentTurn,
+		Roles:           state.Roles,
+		Torus:           state.Torus,
+		PositionHistory: append([]string(nil), state.PositionHistory...),
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+func TestChooseMCTSMove_TakesWin(t *testing.T) {
+	state := &models.GameState{Variant: models.GameVariantClassic, Board: game.NewBoard()}
+	state.Board[0][0] = models.SymbolO
+	state.Board[0][1] = models.SymbolO
+	state.Board[1][0] = models.SymbolX
+	state.Board[1][1] = models.SymbolX
+	state.Board[2][2] = models.SymbolX
+
+	move := ChooseMCTSMove(context.Background(), state, models.SymbolO, MCTSConfig{Iterations: 2000, TimeLimit: time.Minute, Seed: 1})
+
+	if move.Row != 0 || move.Col != 2 {
+		t.Fatalf("expected winning move (0,2), got (%d,%d)", move.Row, move.Col)
+	}
+}
+
+func TestChooseMCTSMove_Blocks(t *testing.T) {
+	state := &models.GameState{Variant: models.GameVariantClassic, Board: game.NewBoard()}
+	state.Board[0][0] = models.SymbolX
+	state.Board[1][1] = models.SymbolO
+	state.Board[2][0] = models.SymbolX
+
+	move := ChooseMCTSMove(context.Background(), state, models.SymbolO, MCTSConfig{Iterations: 5000, TimeLimit: time.Minute, Seed: 1})
+
+	if move.Row != 1 || move.Col != 0 {
+		t.Fatalf("expected block at (1,0), got (%d,%d)", move.Row, move.Col)
+	}
+}
+
+func TestChooseMCTSMove_NumericalMoveIsLegal(t *testing.T) {
+	state := &models.GameState{Variant: models.GameVariantNumerical, Board: game.NewBoard()}
+	state.Board[0][0] = models.NumberSymbol(7)
+	state.Board[0][1] = models.NumberSymbol(2)
+
+	move := ChooseMCTSMove(context.Background(), state, models.SymbolX, MCTSConfig{Iterations: 2000, TimeLimit: time.Minute, Seed: 1})
+
+	// 7 + 2 + 6 = 15 would win, but only O has even numbers; X must play an odd one.
+	if !game.IsNumberAvailable(state.Board, models.SymbolX, move.Number) || !game.IsValidMove(state.Board, move.Row, move.Col) {
+		t.Fatalf("expected a legal numerical move, got %+v", move)
+	}
+}
+
+func TestChooseMCTSMove_StopsWhenContextCancelled(t *testing.T) {
+	state := &models.GameState{
+		Variant: models.GameVariantOrderAndChaos,
+		Board:   game.NewBoardSize(game.OrderChaosSize),
+		Roles:   map[models.Symbol]models.Role{models.SymbolX: models.RoleOrder, models.SymbolO: models.RoleChaos},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	move := ChooseMCTSMove(ctx, state, models.SymbolO, MCTSConfig{Iterations: 1000000, TimeLimit: time.Minute})
+
+	if time.Since(start) > time.Second {
+		t.Fatalf("expected search to stop immediately")
+	}
+	if !game.IsValidMove(state.Board, move.Row, move.Col) || move.Symbol == models.SymbolEmpty {
+		t.Fatalf("expected a legal move, got %+v", move)
+	}
+}
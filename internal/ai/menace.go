@@ -0,0 +1,308 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// Bead counts used by MENACE. Every legal cell of a new box starts with
+// menaceInitialBeads; after a game each move's bead count is adjusted by
+// the reward for the result.
+const (
+	menaceInitialBeads = 3
+	menaceWinReward    = 3
+	menaceDrawReward   = 1
+	menaceLossPenalty  = 1
+)
+
+// Menace is a self-learning classic tic-tac-toe player in the style of Donald
+// Michie's MENACE: one "matchbox" of beads per position, where the number of
+// beads on a cell is the weight of playing there. Positions that are rotations
+// or reflections of each other share a box. Menace is safe for concurrent use.
+type Menace struct {
+	mu    sync.Mutex
+	boxes map[string][]int
+	stats models.TrainingStats
+	rng   *rand.Rand
+	// path is the model file used by Save; empty keeps the model in memory only
+	path string
+}
+
+// menaceFile is the on-disk format of a Menace model.
+type menaceFile struct {
+	Boxes map[string][]int     `json:"boxes"`
+	Stats models.TrainingStats `json:"stats"`
+}
+
+// NewMenace returns an untrained Menace that is not backed by a file.
+func NewMenace() *Menace {
+	return &Menace{
+		boxes: make(map[string][]int),
+		rng:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// LoadMenace loads a Menace model from path. If the file does not exist yet,
+// it returns an untrained model that Save will write to path.
+func LoadMenace(path string) (*Menace, error) {
+	m := NewMenace()
+	m.path = path
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var file menaceFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+	if err := validateBoxes(file.Boxes); err != nil {
+		return nil, fmt.Errorf("loading MENACE model %s: %w", path, err)
+	}
+	if file.Boxes != nil {
+		m.boxes = file.Boxes
+	}
+	m.stats = file.Stats
+	return m, nil
+}
+
+// validateBoxes checks that every box of a loaded model has one bead count
+// per cell of the classic board and no negative counts.
+func validateBoxes(boxes map[string][]int) error {
+	cells := game.BoardSize(models.GameVariantClassic) * game.BoardSize(models.GameVariantClassic)
+	for key, beads := range boxes {
+		if len(beads) != cells {
+			return fmt.Errorf("box %q has %d cells, want %d", key, len(beads), cells)
+		}
+		for _, n := range beads {
+			if n < 0 {
+				return fmt.Errorf("box %q has a negative bead count", key)
+			}
+		}
+	}
+	return nil
+}
+
+// Save writes the model to the file it was loaded from. Models created with
+// NewMenace are not saved.
+func (m *Menace) Save() error {
+	if m.path == "" {
+		return nil
+	}
+
+	m.mu.Lock()
+	data, err := json.Marshal(menaceFile{Boxes: m.boxes, Stats: m.statsLocked()})
+	m.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so a crash never leaves a broken model.
+	tmp, err := os.CreateTemp(filepath.Dir(m.path), filepath.Base(m.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), m.path)
+}
+
+// Stats returns the training progress of the model.
+func (m *Menace) Stats() models.TrainingStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.statsLocked()
+}
+
+func (m *Menace) statsLocked() models.TrainingStats {
+	stats := m.stats
+	stats.Positions = len(m.boxes)
+	return stats
+}
+
+// ChooseMove draws a bead from the box of the position and returns the cell it
+// stands for. Empty boxes are refilled, so Menace never resigns.
+func (m *Menace) ChooseMove(board models.Board) (row, col int) {
+	return m.ChooseMoveWithRand(board, nil)
+}
+
+// ChooseMoveWithRand works like ChooseMove but draws the bead with rng, so
+// that a seeded rng replays the same games. A nil rng uses Menace's own.
+func (m *Menace) ChooseMoveWithRand(board models.Board, rng *rand.Rand) (row, col int) {
+	canonical, symmetry := game.Canonical(board)
+	size := len(board)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	beads := m.boxLocked(canonical)
+	if sum(beads) == 0 {
+		m.refillLocked(canonical, beads)
+	}
+	total := sum(beads)
+	if total == 0 {
+		return -1, -1 // should not happen for a valid in-progress game
+	}
+
+	if rng == nil {
+		rng = m.rng
+	}
+	pick := rng.Intn(total)
+	for i, n := range beads {
+		if pick < n {
+			return game.TransformCell(symmetry.Inverse(), size, i/size, i%size)
+		}
+		pick -= n
+	}
+	return -1, -1
+}
+
+// sum returns the total number of beads in a box.
+func sum(beads []int) int {
+	total := 0
+	for _, n := range beads {
+		total += n
+	}
+	return total
+}
+
+// boxLocked returns the bead box of a canonical board, creating it if needed.
+func (m *Menace) boxLocked(canonical models.Board) []int {
+	key := game.BoardKey(canonical)
+	beads, ok := m.boxes[key]
+	if !ok {
+		beads = make([]int, len(canonical)*len(canonical))
+		m.refillLocked(canonical, beads)
+		m.boxes[key] = beads
+	}
+	return beads
+}
+
+// refillLocked puts the initial beads on every empty cell of the box.
+func (m *Menace) refillLocked(canonical models.Board, beads []int) {
+	for _, cell := range game.AvailableMoves(canonical) {
+		beads[cell[0]*len(canonical)+cell[1]] = menaceInitialBeads
+	}
+}
+
+// Learn reinforces the moves seat played in a finished classic game: beads are
+// added for a win or a draw and removed for a loss. winner is
+// models.SymbolEmpty for a draw. human marks games against human players in
+// the statistics.
+func (m *Menace) Learn(history []models.MoveRecord, seat, winner models.Symbol, human bool) {
+	reward := menaceDrawReward
+	switch winner {
+	case seat:
+		reward = menaceWinReward
+	case game.OppositeSymbol(seat):
+		reward = -menaceLossPenalty
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	board := game.NewBoard()
+	size := len(board)
+	for _, record := range history {
+		if record.Seat == seat {
+			canonical, symmetry := game.Canonical(board)
+			beads := m.boxLocked(canonical)
+			r, c := game.TransformCell(symmetry, size, record.Row, record.Col)
+			if beads[r*size+c] += reward; beads[r*size+c] < 0 {
+				beads[r*size+c] = 0
+			}
+		}
+		board, _ = game.ApplyMove(board, record.Row, record.Col, record.Seat)
+	}
+
+	if human {
+		m.stats.HumanGames++
+		return
+	}
+	m.stats.GamesTrained++
+	switch winner {
+	case seat:
+		m.stats.Wins++
+	case models.SymbolEmpty:
+		m.stats.Draws++
+	default:
+		m.stats.Losses++
+	}
+}
+
+// Train plays games against the heuristic ChooseMove, alternating seats, and
+// learns from each one. It stops early when ctx is cancelled and returns the
+// statistics after training.
+func (m *Menace) Train(ctx context.Context, games int) models.TrainingStats {
+	for i := 0; i < games && ctx.Err() == nil; i++ {
+		seat := models.SymbolX
+		if i%2 == 1 {
+			seat = models.SymbolO
+		}
+		history, winner := m.playTrainingGame(seat)
+		m.Learn(history, seat, winner, false)
+	}
+	return m.Stats()
+}
+
+// playTrainingGame plays one game with Menace in seat against ChooseMove and
+// returns the moves and the winner (models.SymbolEmpty for a draw).
+func (m *Menace) playTrainingGame(seat models.Symbol) ([]models.MoveRecord, models.Symbol) {
+	board := game.NewBoard()
+	turn := models.SymbolX
+	var history []models.MoveRecord
+	for {
+		var row, col int
+		if turn == seat {
+			row, col = m.ChooseMove(board)
+		} else {
+			row, col = ChooseMove(board, turn, game.OppositeSymbol(turn))
+		}
+		board, _ = game.ApplyMove(board, row, col, turn)
+		history = append(history, models.MoveRecord{Seat: turn, Move: models.Move{Row: row, Col: col}})
+
+		if winner, isDraw := game.CheckWinner(board); winner != models.SymbolEmpty || isDraw {
+			return history, winner
+		}
+		turn = game.OppositeSymbol(turn)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+func TestMenace_ChooseMoveIsLegal(t *testing.T) {
+	m := NewMenace()
+	board := game.NewBoard()
+	board[0][0] = models.SymbolX
+	board[1][1] = models.SymbolO
+	board[0][2] = models.SymbolX
+
+	for i := 0; i < 100; i++ {
+		row, col := m.ChooseMove(board)
+		if !game.IsValidMove(board, row, col) {
+			t.Fatalf("illegal move (%d,%d)", row, col)
+		}
+	}
+}
+
+func TestMenace_LearnReinforcesWinningMove(t *testing.T) {
+	m := NewMenace()
+	history := []models.MoveRecord{
+		{Seat: models.SymbolX, Move: models.Move{Row: 1, Col: 1}},
+		{Seat: models.SymbolO, Move: models.Move{Row: 0, Col: 1}},
+		{Seat: models.SymbolX, Move: models.Move{Row: 0, Col: 0}},
+		{Seat: models.SymbolO, Move: models.Move{Row: 2, Col: 2}},
+		{Seat: models.SymbolX, Move: models.Move{Row: 2, Col: 0}},
+		{Seat: models.SymbolO, Move: models.Move{Row: 1, Col: 0}},
+		{Seat: models.SymbolX, Move: models.Move{Row: 0, Col: 2}},
+	}
+	for i := 0; i < 20; i++ {
+		m.Learn(history, models.SymbolX, models.SymbolX, false)
+	}
+
+	// Every win adds beads for the opening move (center) in the empty board's box.
+	beads := m.boxes[game.BoardKey(game.NewBoard())]
+	if want := menaceInitialBeads + 20*menaceWinReward; beads[4] != want {
+		t.Fatalf("expected %d beads on the center, got %d", want, beads[4])
+	}
+	if beads[0] != menaceInitialBeads {
+		t.Fatalf("expected corner beads to stay at %d, got %d", menaceInitialBeads, beads[0])
+	}
+
+	if stats := m.Stats(); stats.GamesTrained != 20 || stats.Wins != 20 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func TestMenace_TrainAndPersist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "menace.json")
+	m, err := LoadMenace(path)
+	if err != nil {
+		t.Fatalf("LoadMenace error = %v", err)
+	}
+
+	stats := m.Train(context.Background(), 50)
+	if stats.GamesTrained != 50 || stats.Wins+stats.Draws+stats.Losses != 50 || stats.Positions == 0 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+	if err := m.Save(); err != nil {
+		t.Fatalf("Save error = %v", err)
+	}
+
+	loaded, err := LoadMenace(path)
+	if err != nil {
+		t.Fatalf("LoadMenace error = %v", err)
+	}
+	if loaded.Stats() != stats {
+		t.Fatalf("expected %+v after reload, got %+v", stats, loaded.Stats())
+	}
+}
+
+func TestMenace_TrainStopsWhenContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if stats := NewMenace().Train(ctx, 1000); stats.GamesTrained != 0 {
+		t.Fatalf("expected no training games, got %d", stats.GamesTrained)
+	}
+}
+
+func TestLoadMenace_RejectsMalformedModel(t *testing.T) {
+	for name, data := range map[string]string{
+		"short box":      `{"boxes": {"x": [1, 1, 1]}}`,
+		"negative beads": `{"boxes": {"x": [1, 1, 1, 1, -1, 1, 1, 1, 1]}}`,
+	} {
+		path := filepath.Join(t.TempDir(), "menace.json")
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatalf("WriteFile error = %v", err)
+		}
+		if _, err := LoadMenace(path); err == nil {
+			t.Fatalf("%s: expected an error for a malformed model", name)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"math/bits"
+	"math/rand"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// morrisSearchDepth is how many moves ahead the Three Men's Morris AI looks
+// once pieces are sliding.
+const morrisSearchDepth = 6
+
+// ChooseMorrisMove picks the next move for the AI in a Three Men's Morris game.
+// While pieces are still being placed it uses the same heuristic as ChooseMove;
+// in the sliding phase it runs a shallow minimax search over slides on a
+// bitboard. If torus is true, lines wrap around the edges of the board.
+func ChooseMorrisMove(board models.Board, aiSymbol, opponentSymbol models.Symbol, torus bool) models.Move {
+	if game.InPlacementPhase(board, aiSymbol) {
+		row, col := ChooseMoveWithTorus(board, aiSymbol, opponentSymbol, torus)
+		return models.Move{Row: row, Col: col}
+	}
+
+	b, err := game.FromBoard(board)
+	if err != nil {
+		return models.Move{Row: -1, Col: -1}
+	}
+	s := morrisSearch{
+		masks:     game.WinMasks(b.Size, b.Size, torus),
+		adjacency: game.MorrisAdjacencyMasks(b.Size),
+	}
+
+	moves := s.moves(b, aiSymbol)
+	if len(moves) == 0 {
+		return models.Move{Row: -1, Col: -1} // should not happen for a valid in-progress game
+	}
+
+	// Shuffle so equally good moves are not always picked in the same order.
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rng.Shuffle(len(moves), func(i, j int) { moves[i], moves[j] = moves[j], moves[i] })
+
+	best, bestScore := moves[0], -morrisWinScore-1
+	for _, move := range moves {
+		score := -s.negamax(move.apply(b, aiSymbol), opponentSymbol, morrisSearchDepth-1)
+		if score > bestScore {
+			best, bestScore = move, score
+		}
+	}
+
+	row, col := b.Cell(best.to)
+	result := models.Move{Row: row, Col: col}
+	if best.from != 0 {
+		fromRow, fromCol := b.Cell(best.from)
+		result.From = &[2]int{fromRow, fromCol}
+	}
+	return result
+}
+
+// morrisWinScore is the score of a won position; faster wins score higher.
+const morrisWinScore = 100
+
+// morrisSearch holds the precomputed masks used by the bitboard search.
+type morrisSearch struct {
+	masks     []uint64
+	adjacency []uint64
+}
+
+// morrisMove is a placement (from == 0) or a slide between two single-bit cells.
+type morrisMove struct {
+	from uint64
+	to   uint64
+}
+
+// apply returns the board after symbol played the move.
+func (m morrisMove) apply(b game.Bitboard, symbol models.Symbol) game.Bitboard {
+	return b.Remove(m.from, symbol).Place(m.to, symbol)
+}
+
+// moves returns all legal moves for symbol, like game.MorrisMoves.
+func (s morrisSearch) moves(b game.Bitboard, symbol models.Symbol) []morrisMove {
+	var moves []morrisMove
+	empty := b.Empty()
+	own := b.Marks(symbol)
+
+	if bits.OnesCount64(own) < game.MorrisPieces {
+		for rest := empty; rest != 0; rest &= rest - 1 {
+			moves = append(moves, morrisMove{to: rest & -rest})
+		}
+		return moves
+	}
+
+	for pieces := own; pieces != 0; pieces &= pieces - 1 {
+		from := pieces & -pieces
+		targets := s.adjacency[bits.TrailingZeros64(from)] & empty
+		for ; targets != 0; targets &= targets - 1 {
+			moves = append(moves, morrisMove{from: from, to: targets & -targets})
+		}
+	}
+	return moves
+}
+
+// negamax scores the position from the point of view of toMove, who is about
+// to move. Positive scores are good for toMove.
+func (s morrisSearch) negamax(b game.Bitboard, toMove models.Symbol, depth int) int {
+	// The previous move may have completed a line for the opponent.
+	if game.HasLine(b.X, s.masks) || game.HasLine(b.O, s.masks) {
+		return -(morrisWinScore + depth)
+	}
+	moves := s.moves(b, toMove)
+	if len(moves) == 0 {
+		return -(morrisWinScore + depth) // blocked players lose
+	}
+	if depth == 0 {
+		return 0
+	}
+
+	best := -morrisWinScore - morrisSearchDepth - 1
+	for _, move := range moves {
+		score := -s.negamax(move.apply(b, toMove), game.OppositeSymbol(toMove), depth-1)
+		if score > best {
+			best = score
+		}
+	}
+	return best
+}
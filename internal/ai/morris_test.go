@@ -0,0 +1,60 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"testing"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+func TestChooseMorrisMove_SlidesIntoWin(t *testing.T) {
+	// X X .
+	// O O X
+	// . O .
+	// X wins by sliding (1,2) -> (0,2).
+	board := game.NewBoard()
+	board[0][0] = models.SymbolX
+	board[0][1] = models.SymbolX
+	board[1][2] = models.SymbolX
+	board[1][0] = models.SymbolO
+	board[1][1] = models.SymbolO
+	board[2][1] = models.SymbolO
+
+	move := ChooseMorrisMove(board, models.SymbolX, models.SymbolO, false)
+
+	if move.From == nil || *move.From != [2]int{1, 2} || move.Row != 0 || move.Col != 2 {
+		t.Fatalf("expected slide (1,2)->(0,2), got %+v", move)
+	}
+}
+
+func TestChooseMorrisMove_PlacesWhilePiecesLeft(t *testing.T) {
+	board := game.NewBoard()
+	board[0][0] = models.SymbolO
+
+	move := ChooseMorrisMove(board, models.SymbolX, models.SymbolO, false)
+
+	if move.From != nil {
+		t.Fatalf("expected a placement, got slide %+v", move)
+	}
+	if move.Row != 1 || move.Col != 1 {
+		t.Fatalf("expected AI to take center (1,1), got (%d,%d)", move.Row, move.Col)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"math/rand"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// ChooseOrderChaosMove picks the next move for the AI in an Order and Chaos game.
+// Both roles first look for a decisive cell (ORDER completes a line, CHAOS spoils
+// ORDER's immediate win); otherwise ORDER maximises and CHAOS minimises the
+// number and length of lines that can still become five in a row. If torus is
+// true, lines wrap around the edges of the board.
+func ChooseOrderChaosMove(board models.Board, role models.Role, torus bool) (row, col int, symbol models.Symbol) {
+	moves := game.AvailableMoves(board)
+	if len(moves) == 0 {
+		return -1, -1, models.SymbolEmpty // should not happen for a valid in-progress game
+	}
+	marks := []models.Symbol{models.SymbolX, models.SymbolO}
+
+	// 1. ORDER: win now. CHAOS: place the opposite mark where ORDER would win.
+	for _, move := range moves {
+		r, c := move[0], move[1]
+		for _, mark := range marks {
+			b, _ := game.ApplyMove(board, r, c, mark)
+			if game.LineWinner(b, game.OrderChaosLine, torus) == models.SymbolEmpty {
+				continue
+			}
+			if role == models.RoleOrder {
+				return r, c, mark
+			}
+			// The spoiling mark must not complete a line of its own.
+			spoil := game.OppositeSymbol(mark)
+			b, _ = game.ApplyMove(board, r, c, spoil)
+			if game.LineWinner(b, game.OrderChaosLine, torus) == models.SymbolEmpty {
+				return r, c, spoil
+			}
+		}
+	}
+
+	// 2. Pick the placement with the best line potential for our role.
+	// Ties are broken randomly so games do not repeat move for move.
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	row, col, symbol = moves[0][0], moves[0][1], models.SymbolX
+	bestScore, ties := 0, 0
+	for _, move := range moves {
+		r, c := move[0], move[1]
+		for _, mark := range marks {
+			b, _ := game.ApplyMove(board, r, c, mark)
+			if role == models.RoleChaos && game.LineWinner(b, game.OrderChaosLine, torus) != models.SymbolEmpty {
+				continue // never complete a line for ORDER
+			}
+			score := orderPotential(b, torus)
+			if role == models.RoleChaos {
+				score = -score
+			}
+
+			switch {
+			case ties == 0 || score > bestScore:
+				bestScore, ties = score, 1
+				row, col, symbol = r, c, mark
+			case score == bestScore:
+				ties++
+				if rng.Intn(ties) == 0 {
+					row, col, symbol = r, c, mark
+				}
+			}
+		}
+	}
+	return row, col, symbol
+}
+
+// orderPotential scores how close ORDER is to five in a row: every window of
+// OrderChaosLine cells that does not mix X and O contributes more the more
+// marks it already holds. Mixed or blocked windows are dead and contribute nothing.
+func orderPotential(board models.Board, torus bool) int {
+	score := 0
+	for _, line := range game.Lines(len(board), game.OrderChaosLine, torus) {
+		xs, os, dead := 0, 0, false
+		for _, cell := range line {
+			switch board[cell[0]][cell[1]] {
+			case models.SymbolX:
+				xs++
+			case models.SymbolO:
+				os++
+			case models.SymbolBlocked:
+				dead = true
+			}
+		}
+		if dead || (xs > 0 && os > 0) {
+			continue
+		}
+		score += 1 << (2 * (xs + os)) // 4^marks
+	}
+	return score
+}
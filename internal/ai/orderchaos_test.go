@@ -0,0 +1,54 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"testing"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+func TestChooseOrderChaosMove_OrderCompletesLine(t *testing.T) {
+	board := game.NewBoardSize(game.OrderChaosSize)
+	for row := 1; row < 5; row++ {
+		board[row][5] = models.SymbolX
+	}
+	board[0][5] = models.SymbolO // only (5,5) can still complete the column
+
+	row, col, symbol := ChooseOrderChaosMove(board, models.RoleOrder, false)
+
+	if row != 5 || col != 5 || symbol != models.SymbolX {
+		t.Fatalf("expected ORDER to complete the column with X at (5,5), got (%d,%d) %q", row, col, symbol)
+	}
+}
+
+func TestChooseOrderChaosMove_ChaosSpoilsLine(t *testing.T) {
+	board := game.NewBoardSize(game.OrderChaosSize)
+	for col := 1; col < 5; col++ {
+		board[3][col] = models.SymbolX
+	}
+	board[3][0] = models.SymbolO // only (3,5) can still complete the row
+
+	row, col, symbol := ChooseOrderChaosMove(board, models.RoleChaos, false)
+
+	if row != 3 || col != 5 || symbol != models.SymbolO {
+		t.Fatalf("expected CHAOS to place O at (3,5), got (%d,%d) %q", row, col, symbol)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// personalityPresets are the personalities clients can pick by name.
+var personalityPresets = map[string]models.Personality{
+	"NOVICE": {
+		BlunderRate: 0.4, CornerPreference: -0.3, Aggression: 0.2,
+		ThinkTime: 1500 * time.Millisecond, ThinkJitter: 1000 * time.Millisecond,
+	},
+	"CASUAL": {
+		BlunderRate: 0.15, CornerPreference: 0.2, Aggression: 0.4,
+		ThinkTime: 800 * time.Millisecond, ThinkJitter: 500 * time.Millisecond,
+	},
+	"AGGRESSIVE": {
+		BlunderRate: 0.05, CornerPreference: 0.5, Aggression: 0.9,
+		ThinkTime: 400 * time.Millisecond, ThinkJitter: 200 * time.Millisecond,
+	},
+	"DEFENSIVE": {
+		BlunderRate: 0.05, CornerPreference: 0.8, Aggression: 0.1,
+		ThinkTime: 600 * time.Millisecond, ThinkJitter: 300 * time.Millisecond,
+	},
+	"MACHINE": {
+		Aggression: 0.5,
+	},
+}
+
+// PersonalityPreset returns the preset personality with the given name.
+func PersonalityPreset(name string) (models.Personality, bool) {
+	p, ok := personalityPresets[name]
+	p.Name = name
+	return p, ok
+}
+
+// PersonalityPresetNames returns the names of all presets in alphabetical order.
+func PersonalityPresetNames() []string {
+	names := make([]string, 0, len(personalityPresets))
+	for name := range personalityPresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ThinkDelay returns how long a player with personality p pauses before a move:
+// ThinkTime varied by up to ThinkJitter, never negative.
+func ThinkDelay(p models.Personality, rng *rand.Rand) time.Duration {
+	delay := p.ThinkTime
+	if p.ThinkJitter > 0 {
+		delay += time.Duration(rng.Int63n(int64(2*p.ThinkJitter)+1)) - p.ThinkJitter
+	}
+	if delay < 0 {
+		return 0
+	}
+	return delay
+}
+
+// Blunders reports whether a player with personality p overlooks the tactics
+// on this move.
+func Blunders(p models.Personality, rng *rand.Rand) bool {
+	return rng.Float64() < p.BlunderRate
+}
+
+// ChooseHumanLikeMove picks a move like ChooseMoveWithTorus, shaped by a
+// personality: a blundering move skips the win and block checks, an aggressive
+// move looks for a fork or a new threat, and otherwise the cell is drawn at
+// random, weighted towards the center and the preferred corners or edges.
+func ChooseHumanLikeMove(board models.Board, aiSymbol, opponentSymbol models.Symbol, torus bool, p models.Personality, rng *rand.Rand) (row, col int) {
+	moves := game.AvailableMoves(board)
+	if len(moves) == 0 {
+		return -1, -1 // should not happen for a valid in-progress game
+	}
+
+	if !Blunders(p, rng) {
+		// 1. Win, 2. block, as the full-strength AI does.
+		if cells := winningCells(board, aiSymbol, torus); len(cells) > 0 {
+			return cells[0][0], cells[0][1]
+		}
+		if cells := winningCells(board, opponentSymbol, torus); len(cells) > 0 {
+			return cells[0][0], cells[0][1]
+		}
+
+		// 3. Aggressive players go for forks first, then for any new threat.
+		if rng.Float64() < p.Aggression {
+			if cells := forkCells(board, aiSymbol, torus); len(cells) > 0 {
+				cell := cells[rng.Intn(len(cells))]
+				return cell[0], cell[1]
+			}
+			if cells := threatCells(board, aiSymbol, torus); len(cells) > 0 {
+				cell := cells[rng.Intn(len(cells))]
+				return cell[0], cell[1]
+			}
+		}
+	}
+
+	// 4. Positional move, weighted by the personality's taste.
+	weights := make([]float64, len(moves))
+	total := 0.0
+	for i, move := range moves {
+		weights[i] = cellWeight(len(board), move[0], move[1], p.CornerPreference)
+		total += weights[i]
+	}
+	pick := rng.Float64() * total
+	for i, move := range moves {
+		if pick < weights[i] {
+			return move[0], move[1]
+		}
+		pick -= weights[i]
+	}
+	last := moves[len(moves)-1]
+	return last[0], last[1]
+}
+
+// cellWeight is the positional weight of a cell: the center counts double,
+// corners and edges are shifted by cornerPreference (-1..1).
+func cellWeight(size, row, col int, cornerPreference float64) float64 {
+	last := size - 1
+	switch {
+	case size%2 == 1 && row == size/2 && col == size/2:
+		return 2
+	case (row == 0 || row == last) && (col == 0 || col == last):
+		return 1 + cornerPreference
+	case row == 0 || row == last || col == 0 || col == last:
+		return 1 - cornerPreference
+	default:
+		return 1
+	}
+}
+
+// threatCells returns the empty cells where symbol would create at least one
+// winning threat.
+func threatCells(board models.Board, symbol models.Symbol, torus bool) [][2]int {
+	var cells [][2]int
+	for _, move := range game.AvailableMoves(board) {
+		b, _ := game.ApplyMove(board, move[0], move[1], symbol)
+		if len(winningCells(b, symbol, torus)) > 0 {
+			cells = append(cells, move)
+		}
+	}
+	return cells
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+func TestPersonalityPreset(t *testing.T) {
+	for _, name := range PersonalityPresetNames() {
+		p, ok := PersonalityPreset(name)
+		if !ok || p.Name != name {
+			t.Fatalf("PersonalityPreset(%q) = %+v, %v", name, p, ok)
+		}
+		if p.BlunderRate < 0 || p.BlunderRate > 1 || p.Aggression < 0 || p.Aggression > 1 {
+			t.Errorf("%s: rates out of range: %+v", name, p)
+		}
+	}
+	if _, ok := PersonalityPreset("GRANDMASTER"); ok {
+		t.Fatalf("expected unknown preset to be rejected")
+	}
+}
+
+func TestThinkDelay_StaysWithinJitter(t *testing.T) {
+	p := models.Personality{ThinkTime: time.Second, ThinkJitter: 300 * time.Millisecond}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		d := ThinkDelay(p, rng)
+		if d < 700*time.Millisecond || d > 1300*time.Millisecond {
+			t.Fatalf("delay %v outside 1s ± 300ms", d)
+		}
+	}
+
+	machine, _ := PersonalityPreset("MACHINE")
+	if d := ThinkDelay(machine, rng); d != 0 {
+		t.Fatalf("expected MACHINE to move instantly, got %v", d)
+	}
+}
+
+func TestChooseHumanLikeMove_NoBlunderTakesWinAndBlocks(t *testing.T) {
+	machine, _ := PersonalityPreset("MACHINE")
+	rng := rand.New(rand.NewSource(1))
+
+	board := game.NewBoard()
+	board[0][0], board[0][1] = models.SymbolO, models.SymbolO
+	board[1][0], board[1][1] = models.SymbolX, models.SymbolX
+	for i := 0; i < 20; i++ {
+		if row, col := ChooseHumanLikeMove(board, models.SymbolO, models.SymbolX, false, machine, rng); row != 0 || col != 2 {
+			t.Fatalf("expected the win at (0,2), got (%d,%d)", row, col)
+		}
+	}
+
+	board = game.NewBoard()
+	board[0][0], board[2][2] = models.SymbolO, models.SymbolX
+	board[1][0], board[1][1] = models.SymbolX, models.SymbolX
+	for i := 0; i < 20; i++ {
+		if row, col := ChooseHumanLikeMove(board, models.SymbolO, models.SymbolX, false, machine, rng); row != 1 || col != 2 {
+			t.Fatalf("expected the block at (1,2), got (%d,%d)", row, col)
+		}
+	}
+}
+
+func TestChooseHumanLikeMove_NoviceSometimesMissesWin(t *testing.T) {
+	novice, _ := PersonalityPreset("NOVICE")
+	rng := rand.New(rand.NewSource(1))
+
+	board := game.NewBoard()
+	board[0][0], board[0][1] = models.SymbolO, models.SymbolO
+	board[1][0], board[1][1] = models.SymbolX, models.SymbolX
+
+	missed := 0
+	for i := 0; i < 200; i++ {
+		row, col := ChooseHumanLikeMove(board, models.SymbolO, models.SymbolX, false, novice, rng)
+		if !game.IsValidMove(board, row, col) {
+			t.Fatalf("illegal move (%d,%d)", row, col)
+		}
+		if row != 0 || col != 2 {
+			missed++
+		}
+	}
+	if missed == 0 || missed == 200 {
+		t.Fatalf("expected NOVICE to miss the win sometimes, missed %d/200", missed)
+	}
+}
+
+func TestCellWeight_CornerPreference(t *testing.T) {
+	if cellWeight(3, 0, 0, 0.5) <= cellWeight(3, 0, 1, 0.5) {
+		t.Fatalf("expected corners to outweigh edges for a positive preference")
+	}
+	if cellWeight(3, 0, 0, -0.5) >= cellWeight(3, 0, 1, -0.5) {
+		t.Fatalf("expected edges to outweigh corners for a negative preference")
+	}
+	if cellWeight(3, 1, 1, 0) != 2 {
+		t.Fatalf("expected the center to count double")
+	}
+}
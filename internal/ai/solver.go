@@ -0,0 +1,309 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"errors"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// ErrUnreachablePosition is returned for boards that cannot occur in a classic
+// 3x3 game, e.g. with too many X's or with both players having three in a row.
+var ErrUnreachablePosition = errors.New("position cannot occur in a classic game")
+
+// evaluation is the perfect-play value of a position for the side to move.
+type evaluation struct {
+	result   models.MoveResult
+	distance int // moves until the game ends
+}
+
+// Solver knows the perfect-play value of every reachable classic 3x3 position.
+// The complete game tree is enumerated once by NewSolver; positions that are
+// rotations or reflections of each other share a single table entry.
+// A Solver is read-only after construction and safe for concurrent use.
+type Solver struct {
+	table map[string]evaluation
+}
+
+// NewSolver enumerates the complete classic game tree and returns a Solver for it.
+func NewSolver() *Solver {
+	s := &Solver{table: make(map[string]evaluation)}
+	s.solve(game.NewBoard(), models.SymbolX)
+	return s
+}
+
+// Positions returns the number of distinct positions (up to symmetry) in the table.
+func (s *Solver) Positions() int {
+	return len(s.table)
+}
+
+// Analyze scores every legal move in the position for the side to move.
+// It returns ErrUnreachablePosition if the board cannot occur in a classic game
+// or toMove is not the player whose turn it is.
+func (s *Solver) Analyze(board models.Board, toMove models.Symbol) (*models.PositionAnalysis, error) {
+	if err := ValidatePosition(board, toMove); err != nil {
+		return nil, err
+	}
+
+	eval, ok := s.table[game.CanonicalKey(board)]
+	if !ok {
+		return nil, ErrUnreachablePosition
+	}
+
+	analysis := &models.PositionAnalysis{
+		ToMove:   toMove,
+		Result:   eval.result,
+		Distance: eval.distance,
+		Moves:    []models.MoveAnalysis{},
+	}
+	if winner, isDraw := game.CheckWinner(board); winner != models.SymbolEmpty || isDraw {
+		return analysis, nil // game over, no moves left
+	}
+
+	for _, move := range game.AvailableMoves(board) {
+		b, _ := game.ApplyMove(board, move[0], move[1], toMove)
+		child := s.table[game.CanonicalKey(b)]
+		analysis.Moves = append(analysis.Moves, models.MoveAnalysis{
+			Row:      move[0],
+			Col:      move[1],
+			Result:   invertResult(child.result),
+			Distance: child.distance + 1,
+		})
+	}
+	return analysis, nil
+}
+
+// Review replays a classic game from the empty board and compares every move
+// with perfect play. A move is BEST if it keeps the best result available, an
+// INACCURACY if it gives away a win but holds the draw and a BLUNDER if it
+// loses a won or drawn position. Accuracy is the percentage of best moves per seat.
+// It returns ErrUnreachablePosition if the history is not a legal classic game.
+func (s *Solver) Review(history []models.MoveRecord) (*models.GameReview, error) {
+	review := &models.GameReview{
+		Moves:    make([]models.MoveReview, 0, len(history)),
+		Accuracy: make(map[models.Symbol]float64),
+	}
+	played := make(map[models.Symbol]int)
+	best := make(map[models.Symbol]int)
+
+	board := game.NewBoard()
+	for i, record := range history {
+		analysis, err := s.Analyze(board, record.Seat)
+		if err != nil {
+			return nil, err
+		}
+
+		mr := models.MoveReview{
+			Ply:        i + 1,
+			Seat:       record.Seat,
+			Row:        record.Row,
+			Col:        record.Col,
+			BestResult: analysis.Result,
+			BestMoves:  [][2]int{},
+		}
+		found := false
+		for _, m := range analysis.Moves {
+			if m.Result == analysis.Result {
+				mr.BestMoves = append(mr.BestMoves, [2]int{m.Row, m.Col})
+			}
+			if m.Row == record.Row && m.Col == record.Col {
+				mr.Result, found = m.Result, true
+			}
+		}
+		if !found {
+			return nil, ErrUnreachablePosition
+		}
+
+		switch {
+		case mr.Result == mr.BestResult:
+			mr.Quality = models.MoveQualityBest
+			best[record.Seat]++
+		case mr.Result == models.MoveResultLoss:
+			mr.Quality = models.MoveQualityBlunder
+		default:
+			mr.Quality = models.MoveQualityInaccuracy
+		}
+		played[record.Seat]++
+		review.Moves = append(review.Moves, mr)
+
+		board, _ = game.ApplyMove(board, record.Row, record.Col, record.Seat)
+	}
+
+	for seat, n := range played {
+		review.Accuracy[seat] = float64(best[seat]) * 100 / float64(n)
+	}
+	return review, nil
+}
+
+// solve computes (and memoises) the value of the position for toMove.
+func (s *Solver) solve(board models.Board, toMove models.Symbol) evaluation {
+	key := game.CanonicalKey(board)
+	if eval, ok := s.table[key]; ok {
+		return eval
+	}
+
+	var best evaluation
+	winner, isDraw := game.CheckWinner(board)
+	switch {
+	case winner != models.SymbolEmpty:
+		// The previous player completed a line.
+		best = evaluation{result: models.MoveResultLoss}
+	case isDraw:
+		best = evaluation{result: models.MoveResultDraw}
+	default:
+		first := true
+		for _, move := range game.AvailableMoves(board) {
+			b, _ := game.ApplyMove(board, move[0], move[1], toMove)
+			child := s.solve(b, game.OppositeSymbol(toMove))
+			candidate := evaluation{result: invertResult(child.result), distance: child.distance + 1}
+			if first || better(candidate, best) {
+				best, first = candidate, false
+			}
+		}
+	}
+
+	s.table[key] = best
+	return best
+}
+
+// resultRank orders results from the point of view of the player choosing a move.
+var resultRank = map[models.MoveResult]int{
+	models.MoveResultLoss: 0,
+	models.MoveResultDraw: 1,
+	models.MoveResultWin:  2,
+}
+
+// better reports whether a is preferable to b for the player choosing between them:
+// wins beat draws beat losses; faster wins and slower losses are preferred.
+func better(a, b evaluation) bool {
+	if resultRank[a.result] != resultRank[b.result] {
+		return resultRank[a.result] > resultRank[b.result]
+	}
+	if a.result == models.MoveResultWin {
+		return a.distance < b.distance
+	}
+	return a.distance > b.distance
+}
+
+// invertResult converts a result for one player into the result for the other.
+func invertResult(result models.MoveResult) models.MoveResult {
+	switch result {
+	case models.MoveResultWin:
+		return models.MoveResultLoss
+	case models.MoveResultLoss:
+		return models.MoveResultWin
+	default:
+		return models.MoveResultDraw
+	}
+}
+
+// ValidatePosition checks that a board can occur in a classic 3x3 game with
+// toMove being the player whose turn it is:
+//   - only "X", "O" and empty cells,
+//   - X moves first, so X has as many marks as O (X to move) or one more (O to move),
+//   - at most one player has three in a row, and that player made the last move,
+//   - all lines of the winner share a cell (the winning move).
+func ValidatePosition(board models.Board, toMove models.Symbol) error {
+	if len(board) != 3 {
+		return ErrUnreachablePosition
+	}
+	xs, os := 0, 0
+	for _, row := range board {
+		if len(row) != 3 {
+			return ErrUnreachablePosition
+		}
+		for _, cell := range row {
+			switch cell {
+			case models.SymbolX:
+				xs++
+			case models.SymbolO:
+				os++
+			case models.SymbolEmpty:
+			default:
+				return ErrUnreachablePosition
+			}
+		}
+	}
+
+	switch {
+	case xs == os && toMove == models.SymbolX:
+	case xs == os+1 && toMove == models.SymbolO:
+	default:
+		return ErrUnreachablePosition
+	}
+
+	xLines, oLines := winningLines(board, models.SymbolX), winningLines(board, models.SymbolO)
+	if len(xLines) > 0 && len(oLines) > 0 {
+		return ErrUnreachablePosition
+	}
+	// The winner must have made the last move, i.e. the other player is to move.
+	if (len(xLines) > 0 && toMove != models.SymbolO) || (len(oLines) > 0 && toMove != models.SymbolX) {
+		return ErrUnreachablePosition
+	}
+	for _, lines := range [][][][2]int{xLines, oLines} {
+		if len(lines) > 1 && !shareCell(lines) {
+			return ErrUnreachablePosition
+		}
+	}
+	return nil
+}
+
+// winningLines returns the lines of the board fully occupied by symbol.
+func winningLines(board models.Board, symbol models.Symbol) [][][2]int {
+	var lines [][][2]int
+	for _, line := range game.Lines(3, 3, false) {
+		full := true
+		for _, cell := range line {
+			if board[cell[0]][cell[1]] != symbol {
+				full = false
+				break
+			}
+		}
+		if full {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// shareCell reports whether one cell belongs to all the given lines.
+func shareCell(lines [][][2]int) bool {
+	for _, cell := range lines[0] {
+		inAll := true
+		for _, line := range lines[1:] {
+			found := false
+			for _, other := range line {
+				if other == cell {
+					found = true
+					break
+				}
+			}
+			if !found {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			return true
+		}
+	}
+	return false
+}
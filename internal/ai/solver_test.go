@@ -0,0 +1,190 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"errors"
+	"testing"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// testSolver is shared by all solver tests, building the table once.
+var testSolver = NewSolver()
+
+func TestNewSolver_EnumeratesAllPositions(t *testing.T) {
+	// 765 is the well-known number of reachable positions up to symmetry.
+	if got := testSolver.Positions(); got != 765 {
+		t.Fatalf("expected 765 positions, got %d", got)
+	}
+}
+
+func TestSolverAnalyze_EmptyBoardIsDraw(t *testing.T) {
+	analysis, err := testSolver.Analyze(game.NewBoard(), models.SymbolX)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if analysis.Result != models.MoveResultDraw {
+		t.Fatalf("expected DRAW, got %s", analysis.Result)
+	}
+	if len(analysis.Moves) != 9 {
+		t.Fatalf("expected 9 moves, got %d", len(analysis.Moves))
+	}
+	for _, m := range analysis.Moves {
+		if m.Result != models.MoveResultDraw {
+			t.Fatalf("expected every opening move to draw, got %+v", m)
+		}
+	}
+}
+
+func TestSolverAnalyze_FindsWinningMove(t *testing.T) {
+	board := game.NewBoard()
+	board[0][0] = models.SymbolX
+	board[0][1] = models.SymbolX
+	board[1][0] = models.SymbolO
+	board[1][1] = models.SymbolO
+
+	analysis, err := testSolver.Analyze(board, models.SymbolX)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if analysis.Result != models.MoveResultWin || analysis.Distance != 1 {
+		t.Fatalf("expected WIN in 1, got %s in %d", analysis.Result, analysis.Distance)
+	}
+	for _, m := range analysis.Moves {
+		if m.Row == 0 && m.Col == 2 {
+			if m.Result != models.MoveResultWin || m.Distance != 1 {
+				t.Fatalf("expected (0,2) to win in 1, got %+v", m)
+			}
+			return
+		}
+	}
+	t.Fatalf("move (0,2) missing from analysis")
+}
+
+func TestSolverAnalyze_FinishedGameHasNoMoves(t *testing.T) {
+	board := game.NewBoard()
+	board[0][0] = models.SymbolX
+	board[0][1] = models.SymbolX
+	board[0][2] = models.SymbolX
+	board[1][0] = models.SymbolO
+	board[1][1] = models.SymbolO
+
+	analysis, err := testSolver.Analyze(board, models.SymbolO)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if analysis.Result != models.MoveResultLoss || len(analysis.Moves) != 0 {
+		t.Fatalf("expected LOSS with no moves, got %+v", analysis)
+	}
+}
+
+func TestSolverAnalyze_RejectsUnreachablePositions(t *testing.T) {
+	tooManyX := game.NewBoard()
+	tooManyX[0][0] = models.SymbolX
+	tooManyX[0][1] = models.SymbolX
+
+	bothWin := game.NewBoard()
+	for col := 0; col < 3; col++ {
+		bothWin[0][col] = models.SymbolX
+		bothWin[1][col] = models.SymbolO
+	}
+
+	wrongTurn := game.NewBoard()
+	wrongTurn[1][1] = models.SymbolX
+
+	tests := []struct {
+		name   string
+		board  models.Board
+		toMove models.Symbol
+	}{
+		{"too many X", tooManyX, models.SymbolO},
+		{"both players win", bothWin, models.SymbolX},
+		{"wrong side to move", wrongTurn, models.SymbolX},
+		{"wrong size", game.NewBoardSize(4), models.SymbolX},
+	}
+	for _, tt := range tests {
+		if _, err := testSolver.Analyze(tt.board, tt.toMove); !errors.Is(err, ErrUnreachablePosition) {
+			t.Errorf("%s: expected ErrUnreachablePosition, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestSolverReview_ClassifiesMoves(t *testing.T) {
+	history := []models.MoveRecord{
+		{Seat: models.SymbolX, Move: models.Move{Row: 1, Col: 1}},
+		{Seat: models.SymbolO, Move: models.Move{Row: 0, Col: 1}}, // edge reply loses
+		{Seat: models.SymbolX, Move: models.Move{Row: 0, Col: 0}},
+		{Seat: models.SymbolO, Move: models.Move{Row: 2, Col: 2}},
+		{Seat: models.SymbolX, Move: models.Move{Row: 2, Col: 0}},
+		{Seat: models.SymbolO, Move: models.Move{Row: 1, Col: 0}},
+		{Seat: models.SymbolX, Move: models.Move{Row: 0, Col: 2}},
+	}
+
+	review, err := testSolver.Review(history)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(review.Moves) != len(history) {
+		t.Fatalf("expected %d reviewed moves, got %d", len(history), len(review.Moves))
+	}
+	if q := review.Moves[1].Quality; q != models.MoveQualityBlunder {
+		t.Fatalf("expected O's edge reply to be a blunder, got %s", q)
+	}
+	for i, m := range review.Moves {
+		if i != 1 && m.Quality != models.MoveQualityBest {
+			t.Errorf("ply %d: expected BEST, got %s", m.Ply, m.Quality)
+		}
+	}
+	if review.Accuracy[models.SymbolX] != 100 {
+		t.Fatalf("expected X accuracy 100, got %v", review.Accuracy[models.SymbolX])
+	}
+	if got := review.Accuracy[models.SymbolO]; got < 66 || got > 67 {
+		t.Fatalf("expected O accuracy ~66.7, got %v", got)
+	}
+}
+
+func TestSolverReview_InaccuracyKeepsDraw(t *testing.T) {
+	// After X center and O edge, X wins with a corner; the opposite edge only draws.
+	history := []models.MoveRecord{
+		{Seat: models.SymbolX, Move: models.Move{Row: 1, Col: 1}},
+		{Seat: models.SymbolO, Move: models.Move{Row: 0, Col: 1}},
+		{Seat: models.SymbolX, Move: models.Move{Row: 2, Col: 1}},
+	}
+
+	review, err := testSolver.Review(history)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	last := review.Moves[2]
+	if last.BestResult != models.MoveResultWin || last.Quality != models.MoveQualityInaccuracy {
+		t.Fatalf("expected an inaccuracy in a won position, got %+v", last)
+	}
+}
+
+func TestSolverReview_RejectsIllegalHistory(t *testing.T) {
+	history := []models.MoveRecord{
+		{Seat: models.SymbolX, Move: models.Move{Row: 1, Col: 1}},
+		{Seat: models.SymbolO, Move: models.Move{Row: 1, Col: 1}},
+	}
+	if _, err := testSolver.Review(history); !errors.Is(err, ErrUnreachablePosition) {
+		t.Fatalf("expected ErrUnreachablePosition, got %v", err)
+	}
+}
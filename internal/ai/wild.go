@@ -0,0 +1,125 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"math/rand"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// ChooseWildMove picks the next move for the AI in a wild game, where either
+// player may place X or O and whoever completes a line wins. If torus is true,
+// lines wrap around the edges of the board.
+func ChooseWildMove(board models.Board, torus bool) models.Move {
+	candidates := func(b models.Board) []models.Move {
+		var moves []models.Move
+		for _, cell := range game.AvailableMoves(b) {
+			for _, mark := range []models.Symbol{models.SymbolX, models.SymbolO} {
+				moves = append(moves, models.Move{Row: cell[0], Col: cell[1], Symbol: mark})
+			}
+		}
+		return moves
+	}
+	place := func(b models.Board, move models.Move) models.Board {
+		next, _ := game.ApplyMove(b, move.Row, move.Col, move.Symbol)
+		return next
+	}
+	return chooseSharedLineMove(board, models.GameVariantWild, torus, candidates, candidates, place)
+}
+
+// ChooseNumericalMove picks the next move for the AI sitting in seat in a
+// numerical game, where a completed line summing to 15 wins for whoever made it.
+// If torus is true, lines wrap around the edges of the board.
+func ChooseNumericalMove(board models.Board, seat models.Symbol, torus bool) models.Move {
+	candidatesFor := func(s models.Symbol) func(models.Board) []models.Move {
+		return func(b models.Board) []models.Move {
+			var moves []models.Move
+			for _, cell := range game.AvailableMoves(b) {
+				for _, n := range game.AvailableNumbers(b, s) {
+					moves = append(moves, models.Move{Row: cell[0], Col: cell[1], Number: n})
+				}
+			}
+			return moves
+		}
+	}
+	place := func(b models.Board, move models.Move) models.Board {
+		next, _ := game.ApplyMove(b, move.Row, move.Col, models.NumberSymbol(move.Number))
+		return next
+	}
+	return chooseSharedLineMove(board, models.GameVariantNumerical, torus, candidatesFor(seat), candidatesFor(game.OppositeSymbol(seat)), place)
+}
+
+// chooseSharedLineMove implements the heuristic for variants in which lines do not
+// belong to a seat: 1) complete a line if possible, 2) avoid moves that let the
+// opponent complete one next turn, 3) prefer the center, 4) pick randomly.
+func chooseSharedLineMove(
+	board models.Board,
+	variant models.GameVariant,
+	torus bool,
+	ownMoves, opponentMoves func(models.Board) []models.Move,
+	place func(models.Board, models.Move) models.Board,
+) models.Move {
+	moves := ownMoves(board)
+	if len(moves) == 0 {
+		return models.Move{Row: -1, Col: -1} // should not happen for a valid in-progress game
+	}
+
+	// 1. Try to win.
+	for _, move := range moves {
+		if game.CompletesLine(variant, place(board, move), torus) {
+			return move
+		}
+	}
+
+	// 2. Keep only moves after which the opponent cannot win immediately.
+	var safe []models.Move
+	for _, move := range moves {
+		next := place(board, move)
+		opponentWins := false
+		for _, reply := range opponentMoves(next) {
+			if game.CompletesLine(variant, place(next, reply), torus) {
+				opponentWins = true
+				break
+			}
+		}
+		if !opponentWins {
+			safe = append(safe, move)
+		}
+	}
+	if len(safe) > 0 {
+		moves = safe
+	}
+
+	// Shuffle so equally good moves are not always picked in the same order.
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rng.Shuffle(len(moves), func(i, j int) { moves[i], moves[j] = moves[j], moves[i] })
+
+	// 3. Take center if possible.
+	for _, move := range moves {
+		if move.Row == 1 && move.Col == 1 {
+			return move
+		}
+	}
+
+	// 4. Random remaining move.
+	return moves[0]
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package ai
+
+import (
+	"testing"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+func TestChooseWildMove_CompletesAnyLine(t *testing.T) {
+	board := game.NewBoard()
+	board[1][0] = models.SymbolO
+	board[1][1] = models.SymbolO
+
+	move := ChooseWildMove(board, false)
+
+	if move.Row != 1 || move.Col != 2 || move.Symbol != models.SymbolO {
+		t.Fatalf("expected O at (1,2), got %+v", move)
+	}
+}
+
+func TestChooseWildMove_DoesNotSetUpOpponent(t *testing.T) {
+	board := game.NewBoard()
+	board[0][0] = models.SymbolX
+
+	move := ChooseWildMove(board, false)
+	next, _ := game.ApplyMove(board, move.Row, move.Col, move.Symbol)
+
+	for _, cell := range game.AvailableMoves(next) {
+		for _, mark := range []models.Symbol{models.SymbolX, models.SymbolO} {
+			reply, _ := game.ApplyMove(next, cell[0], cell[1], mark)
+			if game.LineWinner(reply, 3, false) != models.SymbolEmpty {
+				t.Fatalf("move %+v lets the opponent win at (%d,%d) with %q", move, cell[0], cell[1], mark)
+			}
+		}
+	}
+}
+
+func TestChooseNumericalMove_CompletesFifteen(t *testing.T) {
+	board := game.NewBoard()
+	board[0][0] = models.NumberSymbol(6)
+	board[0][1] = models.NumberSymbol(1)
+	board[2][2] = models.NumberSymbol(3)
+
+	move := ChooseNumericalMove(board, models.SymbolO, false)
+
+	if move.Row != 0 || move.Col != 2 || move.Number != 8 {
+		t.Fatalf("expected 8 at (0,2), got %+v", move)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package bot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+var (
+	// ErrNoCallback is returned for players without a bot callback URL.
+	ErrNoCallback = errors.New("player has no bot callback")
+	// ErrPrivateAddress is returned when a callback would reach the server's
+	// own network instead of a public host.
+	ErrPrivateAddress = errors.New("bot callback address is not public")
+)
+
+// maxResponseSize limits how much of a bot's answer is read.
+const maxResponseSize = 4 << 10
+
+// MoveRequest is the JSON body posted to a bot's callback URL when it is the
+// bot's turn.
+type MoveRequest struct {
+	GameID  string             `json:"gameId"`
+	Variant models.GameVariant `json:"variant"`
+	Torus   bool               `json:"torus"`
+	// Seat is the bot's seat ("X" or "O"); Role its role in asymmetric variants
+	Seat models.Symbol `json:"seat"`
+	Role models.Role   `json:"role,omitempty"`
+	// Board uses "X", "O", "" for empty, "#" for blocked and "1"-"9" in the
+	// numerical variant; Position is the same board in position notation
+	Board    [][]string          `json:"board"`
+	Position string              `json:"position"`
+	Moves    []models.MoveRecord `json:"moves"`
+	// Deadline is when the server stops waiting and the bot forfeits
+	Deadline time.Time `json:"deadline"`
+}
+
+// Client posts positions to bot callback URLs and reads back their moves.
+// It is safe for concurrent use.
+type Client struct {
+	http *http.Client
+}
+
+// NewClient returns a Client with its own HTTP client that only connects to
+// public addresses, so a callback URL cannot be used to reach services on the
+// server's network.
+func NewClient() *Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		// Checked on every connection, after DNS resolution.
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !PublicAddress(ip) {
+				return ErrPrivateAddress
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return NewClientWithHTTP(&http.Client{Transport: transport})
+}
+
+// NewClientWithHTTP returns a Client using a copy of the given HTTP client.
+// Bots must answer their callback themselves, so redirects are never followed.
+func NewClientWithHTTP(httpClient *http.Client) *Client {
+	c := *httpClient
+	c.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	return &Client{http: &c}
+}
+
+// PublicAddress reports whether a bot callback may connect to ip: loopback,
+// private, link-local, multicast and unspecified addresses are refused.
+func PublicAddress(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast())
+}
+
+// RequestMove sends the game to the bot playing seat and returns the move it
+// answers with. The bot only sees the board a player in its seat may see.
+// The deadline of ctx is passed on to the bot; the call fails when it expires.
+func (c *Client) RequestMove(ctx context.Context, bot *models.Player, state *models.GameState, seat models.Symbol) (models.Move, error) {
+	if bot.Bot == nil || bot.Bot.CallbackURL == "" {
+		return models.Move{}, ErrNoCallback
+	}
+
+	visible := game.BoardForPlayer(state, bot.ID)
+	req := MoveRequest{
+		GameID:   state.ID,
+		Variant:  state.Variant,
+		Torus:    state.Torus,
+		Seat:     seat,
+		Role:     state.Roles[seat],
+		Board:    make([][]string, len(visible)),
+		Position: game.FormatPosition(visible, seat),
+		Moves:    state.History,
+	}
+	for i := range visible {
+		req.Board[i] = make([]string, len(visible[i]))
+		for j := range visible[i] {
+			req.Board[i][j] = string(visible[i][j])
+		}
+	}
+	if req.Moves == nil {
+		req.Moves = []models.MoveRecord{}
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		req.Deadline = deadline.UTC()
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return models.Move{}, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, bot.Bot.CallbackURL, bytes.NewReader(body))
+	if err != nil {
+		return models.Move{}, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(httpReq)
+	if err != nil {
+		return models.Move{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return models.Move{}, fmt.Errorf("bot %s answered with status %d", bot.ID, resp.StatusCode)
+	}
+
+	var move models.Move
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&move); err != nil {
+		return models.Move{}, fmt.Errorf("bot %s sent an invalid move: %w", bot.ID, err)
+	}
+	return move, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package bot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+func TestClient_RequestMove(t *testing.T) {
+	var got MoveRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		_ = json.NewEncoder(w).Encode(models.Move{Row: 2, Col: 2})
+	}))
+	defer server.Close()
+
+	bot := &models.Player{ID: "bot-1", Bot: &models.BotAccount{CallbackURL: server.URL}}
+	state := &models.GameState{
+		ID:        "g1",
+		Variant:   models.GameVariantClassic,
+		Board:     game.NewBoard(),
+		PlayerXID: "p1",
+		PlayerOID: "bot-1",
+	}
+	state.Board[1][1] = models.SymbolX
+	state.History = []models.MoveRecord{{Seat: models.SymbolX, Move: models.Move{Row: 1, Col: 1}}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	move, err := NewClientWithHTTP(server.Client()).RequestMove(ctx, bot, state, models.SymbolO)
+	if err != nil {
+		t.Fatalf("RequestMove error = %v", err)
+	}
+	if move.Row != 2 || move.Col != 2 {
+		t.Fatalf("expected move (2,2), got %+v", move)
+	}
+	if got.GameID != "g1" || got.Seat != models.SymbolO || got.Position != ".../.X./... o" ||
+		got.Board[1][1] != "X" || len(got.Moves) != 1 || got.Deadline.IsZero() {
+		t.Fatalf("unexpected request %+v", got)
+	}
+}
+
+func TestClient_RequestMove_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "busy", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	state := &models.GameState{ID: "g1", Board: game.NewBoard()}
+	client := NewClientWithHTTP(server.Client())
+
+	if _, err := client.RequestMove(context.Background(), &models.Player{ID: "p1"}, state, models.SymbolO); err != ErrNoCallback {
+		t.Fatalf("expected ErrNoCallback, got %v", err)
+	}
+	bot := &models.Player{ID: "bot-1", Bot: &models.BotAccount{CallbackURL: server.URL}}
+	if _, err := client.RequestMove(context.Background(), bot, state, models.SymbolO); err == nil {
+		t.Fatalf("expected an error for a non-200 answer")
+	}
+}
+
+func TestClient_RequestMove_Redirect(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("redirect to %s was followed", r.URL)
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer server.Close()
+
+	state := &models.GameState{ID: "g1", Board: game.NewBoard()}
+	bot := &models.Player{ID: "bot-1", Bot: &models.BotAccount{CallbackURL: server.URL}}
+	if _, err := NewClientWithHTTP(server.Client()).RequestMove(context.Background(), bot, state, models.SymbolO); err == nil {
+		t.Fatalf("expected an error for a redirect")
+	}
+}
+
+func TestClient_RequestMove_PrivateAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("private callback was called")
+	}))
+	defer server.Close()
+
+	state := &models.GameState{ID: "g1", Board: game.NewBoard()}
+	bot := &models.Player{ID: "bot-1", Bot: &models.BotAccount{CallbackURL: server.URL}}
+	if _, err := NewClient().RequestMove(context.Background(), bot, state, models.SymbolO); !errors.Is(err, ErrPrivateAddress) {
+		t.Fatalf("expected ErrPrivateAddress, got %v", err)
+	}
+}
+
+func TestPublicAddress(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"93.184.216.34":   true,
+		"2606:4700::1111": true,
+		"127.0.0.1":       false,
+		"10.1.2.3":        false,
+		"192.168.0.10":    false,
+		"169.254.169.254": false,
+		"0.0.0.0":         false,
+		"::1":             false,
+		"fe80::1":         false,
+		"fd00::1":         false,
+	} {
+		if got := PublicAddress(net.ParseIP(addr)); got != want {
+			t.Errorf("PublicAddress(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
@@ -0,0 +1,2 @@
+// Package bot talks to external engines that play through the bot API.
+package bot
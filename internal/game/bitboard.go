@@ -0,0 +1,230 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import (
+	"fmt"
+	"math/bits"
+	"sync"
+
+	"tic-tac-go/internal/models"
+)
+
+// MaxBitboardSize is the largest board side length that fits into a Bitboard.
+const MaxBitboardSize = 8
+
+// Bitboard is a compact board representation for fast search. Each cell is
+// one bit (row*Size + col) in the X, O and Blocked masks. Bitboards are values:
+// every operation returns a new board and never allocates.
+type Bitboard struct {
+	Size    int
+	X       uint64
+	O       uint64
+	Blocked uint64
+}
+
+// winMaskKey identifies a cached set of win masks.
+type winMaskKey struct {
+	size   int
+	length int
+	torus  bool
+}
+
+// winMaskCache holds the precomputed win masks, keyed by winMaskKey.
+var winMaskCache sync.Map
+
+// NewBitboard returns an empty size x size bitboard.
+func NewBitboard(size int) Bitboard {
+	return Bitboard{Size: size}
+}
+
+// FromBoard converts a board to a bitboard. It returns an error for boards
+// larger than MaxBitboardSize and for cells holding numbers.
+func FromBoard(board models.Board) (Bitboard, error) {
+	size := len(board)
+	if size > MaxBitboardSize {
+		return Bitboard{}, fmt.Errorf("board size %d does not fit a bitboard", size)
+	}
+
+	b := NewBitboard(size)
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			bit := b.Bit(row, col)
+			switch board[row][col] {
+			case models.SymbolEmpty:
+			case models.SymbolX:
+				b.X |= bit
+			case models.SymbolO:
+				b.O |= bit
+			case models.SymbolBlocked:
+				b.Blocked |= bit
+			default:
+				return Bitboard{}, fmt.Errorf("cell %q at row=%d col=%d has no bitboard representation", board[row][col], row, col)
+			}
+		}
+	}
+	return b, nil
+}
+
+// ToBoard converts the bitboard back to a models.Board.
+func (b Bitboard) ToBoard() models.Board {
+	board := NewBoardSize(b.Size)
+	for row := 0; row < b.Size; row++ {
+		for col := 0; col < b.Size; col++ {
+			bit := b.Bit(row, col)
+			switch {
+			case b.X&bit != 0:
+				board[row][col] = models.SymbolX
+			case b.O&bit != 0:
+				board[row][col] = models.SymbolO
+			case b.Blocked&bit != 0:
+				board[row][col] = models.SymbolBlocked
+			}
+		}
+	}
+	return board
+}
+
+// Bit returns the mask of the cell (row, col).
+func (b Bitboard) Bit(row, col int) uint64 {
+	return 1 << uint(row*b.Size+col)
+}
+
+// Cell returns the (row, col) of a single-bit mask.
+func (b Bitboard) Cell(bit uint64) (row, col int) {
+	i := bits.TrailingZeros64(bit)
+	return i / b.Size, i % b.Size
+}
+
+// Full returns the mask of all cells of the board.
+func (b Bitboard) Full() uint64 {
+	n := b.Size * b.Size
+	if n == 64 {
+		return ^uint64(0)
+	}
+	return 1<<uint(n) - 1
+}
+
+// Empty returns the mask of the cells nobody can claim yet.
+func (b Bitboard) Empty() uint64 {
+	return b.Full() &^ (b.X | b.O | b.Blocked)
+}
+
+// Marks returns the mask of the cells holding symbol.
+func (b Bitboard) Marks(symbol models.Symbol) uint64 {
+	switch symbol {
+	case models.SymbolX:
+		return b.X
+	case models.SymbolO:
+		return b.O
+	case models.SymbolBlocked:
+		return b.Blocked
+	default:
+		return 0
+	}
+}
+
+// Place returns the board with symbol (X or O) added on the cells in mask.
+// It does not check that the cells are empty.
+func (b Bitboard) Place(mask uint64, symbol models.Symbol) Bitboard {
+	if symbol == models.SymbolX {
+		b.X |= mask
+	} else {
+		b.O |= mask
+	}
+	return b
+}
+
+// Remove returns the board with symbol's marks removed from the cells in mask.
+func (b Bitboard) Remove(mask uint64, symbol models.Symbol) Bitboard {
+	if symbol == models.SymbolX {
+		b.X &^= mask
+	} else {
+		b.O &^= mask
+	}
+	return b
+}
+
+// IsFull reports whether no empty cells are left.
+func (b Bitboard) IsFull() bool {
+	return b.Empty() == 0
+}
+
+// Winner returns the symbol with length marks in a row, or models.SymbolEmpty.
+// If torus is true, lines wrap around the edges of the board.
+func (b Bitboard) Winner(length int, torus bool) models.Symbol {
+	masks := WinMasks(b.Size, length, torus)
+	if HasLine(b.X, masks) {
+		return models.SymbolX
+	}
+	if HasLine(b.O, masks) {
+		return models.SymbolO
+	}
+	return models.SymbolEmpty
+}
+
+// HasLine reports whether marks covers at least one of the win masks.
+func HasLine(marks uint64, masks []uint64) bool {
+	for _, m := range masks {
+		if marks&m == m {
+			return true
+		}
+	}
+	return false
+}
+
+// WinMasks returns one mask per line of the given length on a size x size
+// board, as listed by Lines. Masks are computed once and cached.
+func WinMasks(size, length int, torus bool) []uint64 {
+	key := winMaskKey{size: size, length: length, torus: torus}
+	if cached, ok := winMaskCache.Load(key); ok {
+		return cached.([]uint64)
+	}
+
+	b := NewBitboard(size)
+	lines := Lines(size, length, torus)
+	masks := make([]uint64, len(lines))
+	for i, line := range lines {
+		for _, cell := range line {
+			masks[i] |= b.Bit(cell[0], cell[1])
+		}
+	}
+
+	winMaskCache.Store(key, masks)
+	return masks
+}
+
+// MorrisAdjacencyMasks returns, for every cell index of a size x size board,
+// the mask of the cells a Three Men's Morris piece may slide to (see IsAdjacent).
+func MorrisAdjacencyMasks(size int) []uint64 {
+	b := NewBitboard(size)
+	masks := make([]uint64, size*size)
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			for toRow := 0; toRow < size; toRow++ {
+				for toCol := 0; toCol < size; toCol++ {
+					if IsAdjacent(row, col, toRow, toCol) {
+						masks[row*size+col] |= b.Bit(toRow, toCol)
+					}
+				}
+			}
+		}
+	}
+	return masks
+}
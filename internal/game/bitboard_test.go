@@ -0,0 +1,135 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import (
+	"math/rand"
+	"testing"
+
+	"tic-tac-go/internal/models"
+)
+
+// randomBoard fills a size x size board with random X, O, blocked and empty cells.
+func randomBoard(rng *rand.Rand, size int) models.Board {
+	symbols := []models.Symbol{models.SymbolEmpty, models.SymbolX, models.SymbolO, models.SymbolBlocked}
+	board := NewBoardSize(size)
+	for r := range board {
+		for c := range board[r] {
+			board[r][c] = symbols[rng.Intn(len(symbols))]
+		}
+	}
+	return board
+}
+
+func TestBitboard_RoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, size := range []int{3, 6, 8} {
+		board := randomBoard(rng, size)
+		b, err := FromBoard(board)
+		if err != nil {
+			t.Fatalf("FromBoard error = %v", err)
+		}
+		if BoardKey(b.ToBoard()) != BoardKey(board) {
+			t.Fatalf("size %d: round trip mismatch", size)
+		}
+	}
+}
+
+func TestFromBoard_RejectsUnsupportedBoards(t *testing.T) {
+	if _, err := FromBoard(NewBoardSize(9)); err == nil {
+		t.Fatalf("expected error for a 9x9 board")
+	}
+	board := NewBoard()
+	board[0][0] = models.NumberSymbol(5)
+	if _, err := FromBoard(board); err == nil {
+		t.Fatalf("expected error for a numbered cell")
+	}
+}
+
+func TestBitboardWinner_MatchesLineWinner(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for i := 0; i < 2000; i++ {
+		size := 3 + rng.Intn(4)
+		length := 3 + rng.Intn(size-2)
+		torus := rng.Intn(2) == 0
+		board := randomBoard(rng, size)
+
+		b, _ := FromBoard(board)
+		got := b.Winner(length, torus)
+		if got == models.SymbolEmpty {
+			if want := LineWinner(board, length, torus); want != models.SymbolEmpty {
+				t.Fatalf("expected %s to win on %s", want, BoardKey(board))
+			}
+			continue
+		}
+		// Random boards may contain lines for both symbols; the winner found
+		// must at least own a line.
+		if !HasLine(b.Marks(got), WinMasks(size, length, torus)) {
+			t.Fatalf("reported winner %s has no line on %s", got, BoardKey(board))
+		}
+	}
+}
+
+func TestBitboard_EmptyAndFull(t *testing.T) {
+	board := NewBoard()
+	board[0][0] = models.SymbolX
+	board[1][1] = models.SymbolBlocked
+	b, _ := FromBoard(board)
+
+	if got := b.Empty(); got != b.Full()&^(b.Bit(0, 0)|b.Bit(1, 1)) {
+		t.Fatalf("unexpected empty mask %b", got)
+	}
+	if b.IsFull() {
+		t.Fatalf("expected board not to be full")
+	}
+	if row, col := b.Cell(b.Bit(2, 1)); row != 2 || col != 1 {
+		t.Fatalf("expected (2,1), got (%d,%d)", row, col)
+	}
+}
+
+func TestMorrisAdjacencyMasks(t *testing.T) {
+	masks := MorrisAdjacencyMasks(3)
+	b := NewBitboard(3)
+
+	// The centre connects to every other cell, a corner only to its two
+	// neighbours and the centre.
+	if masks[4] != b.Full()&^b.Bit(1, 1) {
+		t.Fatalf("unexpected centre adjacency %b", masks[4])
+	}
+	if want := b.Bit(0, 1) | b.Bit(1, 0) | b.Bit(1, 1); masks[0] != want {
+		t.Fatalf("expected corner adjacency %b, got %b", want, masks[0])
+	}
+}
+
+func BenchmarkCheckWinner_Board(b *testing.B) {
+	board := randomBoard(rand.New(rand.NewSource(3)), 6)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		LineWinner(board, 5, false)
+	}
+}
+
+func BenchmarkCheckWinner_Bitboard(b *testing.B) {
+	bb, _ := FromBoard(randomBoard(rand.New(rand.NewSource(3)), 6))
+	WinMasks(6, 5, false)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bb.Winner(5, false)
+	}
+}
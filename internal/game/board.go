@@ -20,6 +20,7 @@ package game
 
 import (
 	"fmt"
+	"strings"
 
 	"tic-tac-go/internal/models"
 )
@@ -38,18 +39,35 @@ func OppositeSymbol(s models.Symbol) models.Symbol {
 
 // NewBoard creates a new empty 3x3 game board
 func NewBoard() models.Board {
-	var board models.Board
-	for row := 0; row < 3; row++ {
-		for col := 0; col < 3; col++ {
+	return NewBoardSize(3)
+}
+
+// NewBoardSize creates a new empty square board with the given number of rows and columns
+func NewBoardSize(size int) models.Board {
+	board := make(models.Board, size)
+	for row := 0; row < size; row++ {
+		board[row] = make([]models.Symbol, size)
+		for col := 0; col < size; col++ {
 			board[row][col] = models.SymbolEmpty
 		}
 	}
 	return board
 }
 
+// CloneBoard returns a deep copy of the board, so the copy can be changed
+// without affecting the original
+func CloneBoard(board models.Board) models.Board {
+	clone := make(models.Board, len(board))
+	for row := range board {
+		clone[row] = make([]models.Symbol, len(board[row]))
+		copy(clone[row], board[row])
+	}
+	return clone
+}
+
 // IsValidMove reports whether a move by a player is within the boiunds and on an empty cell of the board
 func IsValidMove(board models.Board, row, col int) bool {
-	if row < 0 || row >= 3 || col < 0 || col >= 3 {
+	if row < 0 || row >= len(board) || col < 0 || col >= len(board) {
 		return false
 	}
 	return board[row][col] == models.SymbolEmpty // check if selected cell is empty
@@ -62,15 +80,15 @@ func ApplyMove(board models.Board, row, col int, symbol models.Symbol) (models.B
 		return board, fmt.Errorf("invalid move at row=%d col=%d", row, col)
 	}
 
-	newBoard := board
+	newBoard := CloneBoard(board)
 	newBoard[row][col] = symbol
 	return newBoard, nil
 }
 
 // IsFull reports whether the board as no empty cells left
 func IsFull(board models.Board) bool {
-	for row := 0; row < 3; row++ {
-		for col := 0; col < 3; col++ {
+	for row := 0; row < len(board); row++ {
+		for col := 0; col < len(board); col++ {
 			if board[row][col] == models.SymbolEmpty {
 				return false
 			}
@@ -82,8 +100,8 @@ func IsFull(board models.Board) bool {
 // AvailableMoves return a slice of all empty positions as [row, col] pairs
 func AvailableMoves(board models.Board) [][2]int {
 	var moves [][2]int
-	for row := 0; row < 3; row++ {
-		for col := 0; col < 3; col++ {
+	for row := 0; row < len(board); row++ {
+		for col := 0; col < len(board); col++ {
 			if board[row][col] == models.SymbolEmpty {
 				moves = append(moves, [2]int{row, col})
 			}
@@ -91,3 +109,35 @@ func AvailableMoves(board models.Board) [][2]int {
 	}
 	return moves
 }
+
+// BlockCells returns a new board with the given [row, col] cells marked as
+// permanently blocked. It returns an error if a cell is out of bounds or not empty.
+func BlockCells(board models.Board, cells [][2]int) (models.Board, error) {
+	newBoard := CloneBoard(board)
+	for _, cell := range cells {
+		if !IsValidMove(newBoard, cell[0], cell[1]) {
+			return board, fmt.Errorf("cannot block cell at row=%d col=%d", cell[0], cell[1])
+		}
+		newBoard[cell[0]][cell[1]] = models.SymbolBlocked
+	}
+	return newBoard, nil
+}
+
+// BoardKey encodes a board as a compact string with rows separated by '|' and
+// empty cells written as '.', e.g. "XO.|.X.|..O".
+func BoardKey(board models.Board) string {
+	var sb strings.Builder
+	for row := range board {
+		if row > 0 {
+			sb.WriteByte('|')
+		}
+		for col := range board[row] {
+			if board[row][col] == models.SymbolEmpty {
+				sb.WriteByte('.')
+			} else {
+				sb.WriteString(string(board[row][col]))
+			}
+		}
+	}
+	return sb.String()
+}
@@ -68,3 +68,22 @@ func TestApplyMove_ValidAndInvalid(t *testing.T) {
 		t.Fatalf("expected error for invalid move, got nil")
 	}
 }
+
+func TestBlockCells(t *testing.T) {
+	board := NewBoard()
+
+	blocked, err := BlockCells(board, [][2]int{{1, 1}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if IsValidMove(blocked, 1, 1) {
+		t.Fatalf("expected blocked cell (1,1) to be an invalid move")
+	}
+	if len(AvailableMoves(blocked)) != 8 {
+		t.Fatalf("expected 8 available moves, got %d", len(AvailableMoves(blocked)))
+	}
+
+	if _, err := BlockCells(board, [][2]int{{3, 0}}); err == nil {
+		t.Fatalf("expected error for out-of-bounds blocked cell")
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import (
+	"time"
+
+	"tic-tac-go/internal/models"
+)
+
+// StartClocks gives both seats the initial time of the game's time control
+// and starts the clock of the seat to move. Games without a time control are
+// left untouched.
+func StartClocks(state *models.GameState, now time.Time) {
+	if state.TimeControl == nil {
+		return
+	}
+	state.Clocks = map[models.Symbol]time.Duration{
+		models.SymbolX: state.TimeControl.Initial,
+		models.SymbolO: state.TimeControl.Initial,
+	}
+	state.TurnStartedAt = now
+}
+
+// RemainingTime returns the thinking time seat has left at now. Only the
+// clock of the seat to move is running. ok is false if the game has no
+// running clocks.
+func RemainingTime(state *models.GameState, seat models.Symbol, now time.Time) (left time.Duration, ok bool) {
+	if state.Clocks == nil {
+		return 0, false
+	}
+	left = state.Clocks[seat]
+	if seat == state.CurrentTurn && state.Status == models.GameStatusInProgress {
+		left -= now.Sub(state.TurnStartedAt)
+	}
+	if left < 0 {
+		left = 0
+	}
+	return left, true
+}
+
+// PressClock ends seat's turn at now: the time seat spent thinking is taken
+// from its clock, the increment is added and the opponent's clock starts.
+func PressClock(state *models.GameState, seat models.Symbol, now time.Time) {
+	if state.Clocks == nil {
+		return
+	}
+	state.Clocks[seat] -= now.Sub(state.TurnStartedAt)
+	if state.Clocks[seat] < 0 {
+		state.Clocks[seat] = 0
+	}
+	state.Clocks[seat] += state.TimeControl.Increment
+	state.TurnStartedAt = now
+}
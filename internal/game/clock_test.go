@@ -0,0 +1,62 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import (
+	"testing"
+	"time"
+
+	"tic-tac-go/internal/models"
+)
+
+func TestClocks_RunForSeatToMove(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	state := &models.GameState{
+		Status:      models.GameStatusInProgress,
+		CurrentTurn: models.SymbolX,
+		TimeControl: &models.TimeControl{Initial: time.Minute, Increment: 2 * time.Second},
+	}
+	StartClocks(state, start)
+
+	if left, ok := RemainingTime(state, models.SymbolX, start.Add(10*time.Second)); !ok || left != 50*time.Second {
+		t.Fatalf("expected 50s left for X, got %v (ok=%v)", left, ok)
+	}
+	if left, _ := RemainingTime(state, models.SymbolO, start.Add(10*time.Second)); left != time.Minute {
+		t.Fatalf("expected O's clock to stand still, got %v", left)
+	}
+
+	PressClock(state, models.SymbolX, start.Add(10*time.Second))
+	state.CurrentTurn = models.SymbolO
+
+	if left, _ := RemainingTime(state, models.SymbolX, start.Add(time.Hour)); left != 52*time.Second {
+		t.Fatalf("expected 52s left for X after the increment, got %v", left)
+	}
+	if left, _ := RemainingTime(state, models.SymbolO, start.Add(2*time.Minute)); left != 0 {
+		t.Fatalf("expected O's flag to have fallen, got %v", left)
+	}
+}
+
+func TestClocks_UntimedGame(t *testing.T) {
+	state := &models.GameState{Status: models.GameStatusInProgress, CurrentTurn: models.SymbolX}
+	StartClocks(state, time.Now())
+
+	if _, ok := RemainingTime(state, models.SymbolX, time.Now()); ok {
+		t.Fatal("expected no clock in an untimed game")
+	}
+}
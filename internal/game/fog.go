@@ -0,0 +1,86 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import "tic-tac-go/internal/models"
+
+// VisibleBoard returns the board as seen by the player with the given symbol
+// in a fog-of-war game: only blocked cells, the viewer's own marks and the
+// opponent cells listed in revealed are shown, every other cell appears empty.
+func VisibleBoard(board models.Board, viewer models.Symbol, revealed [][2]int) models.Board {
+	view := NewBoardSize(len(board))
+	for row := 0; row < len(board); row++ {
+		for col := 0; col < len(board); col++ {
+			if board[row][col] == models.SymbolBlocked {
+				view[row][col] = models.SymbolBlocked
+			}
+		}
+	}
+	if viewer == models.SymbolEmpty {
+		return view // spectators see nothing while the game is running
+	}
+
+	for row := 0; row < len(board); row++ {
+		for col := 0; col < len(board); col++ {
+			if board[row][col] == viewer {
+				view[row][col] = viewer
+			}
+		}
+	}
+	for _, cell := range revealed {
+		view[cell[0]][cell[1]] = board[cell[0]][cell[1]]
+	}
+	return view
+}
+
+// SymbolForPlayer returns the symbol the given player plays with in the game,
+// or models.SymbolEmpty if the player is not a participant.
+func SymbolForPlayer(state *models.GameState, playerID string) models.Symbol {
+	switch {
+	case playerID == "":
+		return models.SymbolEmpty
+	case playerID == state.PlayerXID:
+		return models.SymbolX
+	case playerID == state.PlayerOID:
+		return models.SymbolO
+	default:
+		return models.SymbolEmpty
+	}
+}
+
+// BoardForPlayer returns the board a player is allowed to see. Classic games
+// and finished fog-of-war games are fully revealed; a running fog-of-war game
+// is filtered through VisibleBoard.
+func BoardForPlayer(state *models.GameState, playerID string) models.Board {
+	if state.Variant != models.GameVariantFogOfWar || state.Status == models.GameStatusFinished {
+		return state.Board
+	}
+	viewer := SymbolForPlayer(state, playerID)
+	return VisibleBoard(state.Board, viewer, state.Revealed[viewer])
+}
+
+// IsRevealed reports whether the given cell is listed in revealed.
+func IsRevealed(revealed [][2]int, row, col int) bool {
+	for _, cell := range revealed {
+		if cell[0] == row && cell[1] == col {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import (
+	"testing"
+
+	"tic-tac-go/internal/models"
+)
+
+func TestVisibleBoard_HidesOpponentMarks(t *testing.T) {
+	board := NewBoard()
+	board[0][0] = models.SymbolX
+	board[1][1] = models.SymbolO
+	board[2][2] = models.SymbolO
+
+	view := VisibleBoard(board, models.SymbolX, [][2]int{{2, 2}})
+
+	if view[0][0] != models.SymbolX {
+		t.Fatalf("expected own mark at (0,0), got %q", view[0][0])
+	}
+	if view[1][1] != models.SymbolEmpty {
+		t.Fatalf("expected hidden opponent mark at (1,1), got %q", view[1][1])
+	}
+	if view[2][2] != models.SymbolO {
+		t.Fatalf("expected revealed opponent mark at (2,2), got %q", view[2][2])
+	}
+}
+
+func TestBoardForPlayer_FullRevealWhenFinished(t *testing.T) {
+	state := &models.GameState{
+		Variant:   models.GameVariantFogOfWar,
+		Board:     NewBoard(),
+		PlayerXID: "pX",
+		PlayerOID: "pO",
+		Status:    models.GameStatusInProgress,
+	}
+	state.Board[0][1] = models.SymbolO
+
+	if got := BoardForPlayer(state, "pX")[0][1]; got != models.SymbolEmpty {
+		t.Fatalf("expected O to be hidden from X while running, got %q", got)
+	}
+
+	state.Status = models.GameStatusFinished
+	if got := BoardForPlayer(state, "pX")[0][1]; got != models.SymbolO {
+		t.Fatalf("expected O to be revealed after the game finished, got %q", got)
+	}
+}
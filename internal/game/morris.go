@@ -0,0 +1,151 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import (
+	"fmt"
+
+	"tic-tac-go/internal/models"
+)
+
+const (
+	// MorrisPieces is the number of pieces each player places in Three Men's Morris
+	MorrisPieces = 3
+	// MorrisMaxPlies is the total number of moves after which the game is drawn
+	MorrisMaxPlies = 60
+	// MorrisRepetitions is how often the same position may occur before the game is drawn
+	MorrisRepetitions = 3
+)
+
+// CountSymbol returns how many cells of the board hold the given symbol
+func CountSymbol(board models.Board, symbol models.Symbol) int {
+	n := 0
+	for row := range board {
+		for col := range board[row] {
+			if board[row][col] == symbol {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// InPlacementPhase reports whether the player with the given symbol still has
+// pieces to place. Once all pieces are on the board the player slides instead.
+func InPlacementPhase(board models.Board, symbol models.Symbol) bool {
+	return CountSymbol(board, symbol) < MorrisPieces
+}
+
+// IsAdjacent reports whether two cells are connected by a line of the Three Men's
+// Morris board: horizontal and vertical neighbours, plus the diagonal steps that
+// run through the centre.
+func IsAdjacent(fromRow, fromCol, toRow, toCol int) bool {
+	dr, dc := toRow-fromRow, toCol-fromCol
+	if dr < -1 || dr > 1 || dc < -1 || dc > 1 || (dr == 0 && dc == 0) {
+		return false
+	}
+	if dr != 0 && dc != 0 {
+		// Diagonal steps only exist along the two main diagonals.
+		return (fromRow == 1 && fromCol == 1) || (toRow == 1 && toCol == 1)
+	}
+	return true
+}
+
+// ApplySlide returns a new board with the symbol's piece moved from one cell to
+// an adjacent empty cell. If the slide is invalid, it returns an error.
+func ApplySlide(board models.Board, from, to [2]int, symbol models.Symbol) (models.Board, error) {
+	size := len(board)
+	if from[0] < 0 || from[0] >= size || from[1] < 0 || from[1] >= size ||
+		board[from[0]][from[1]] != symbol ||
+		!IsValidMove(board, to[0], to[1]) ||
+		!IsAdjacent(from[0], from[1], to[0], to[1]) {
+		return board, fmt.Errorf("invalid slide from row=%d col=%d to row=%d col=%d", from[0], from[1], to[0], to[1])
+	}
+
+	newBoard := CloneBoard(board)
+	newBoard[from[0]][from[1]] = models.SymbolEmpty
+	newBoard[to[0]][to[1]] = symbol
+	return newBoard, nil
+}
+
+// MorrisMoves returns all legal Three Men's Morris moves for the given symbol:
+// placements while pieces are left, adjacent slides afterwards.
+func MorrisMoves(board models.Board, symbol models.Symbol) []models.Move {
+	var moves []models.Move
+	if InPlacementPhase(board, symbol) {
+		for _, cell := range AvailableMoves(board) {
+			moves = append(moves, models.Move{Row: cell[0], Col: cell[1]})
+		}
+		return moves
+	}
+
+	for row := range board {
+		for col := range board[row] {
+			if board[row][col] != symbol {
+				continue
+			}
+			for _, cell := range AvailableMoves(board) {
+				if IsAdjacent(row, col, cell[0], cell[1]) {
+					moves = append(moves, models.Move{Row: cell[0], Col: cell[1], From: &[2]int{row, col}})
+				}
+			}
+		}
+	}
+	return moves
+}
+
+// PositionKey encodes a board and the side to move as a compact string,
+// e.g. "XO.|.X.|..O X". It is used to detect repeated positions.
+func PositionKey(board models.Board, toMove models.Symbol) string {
+	return BoardKey(board) + " " + string(toMove)
+}
+
+// MorrisResult checks a Three Men's Morris position where toMove is the player
+// about to move and history holds the position keys after every move so far.
+// It returns:
+//   - winner: the symbol with three in a row, or the opponent of a player who
+//     has no legal move left; models.SymbolEmpty otherwise.
+//   - isDraw: true if the position occurred MorrisRepetitions times or the
+//     game reached MorrisMaxPlies moves.
+//
+// If torus is true, lines wrap around the edges of the board.
+func MorrisResult(board models.Board, toMove models.Symbol, history []string, torus bool) (winner models.Symbol, isDraw bool) {
+	if winner := LineWinner(board, len(board), torus); winner != models.SymbolEmpty {
+		return winner, false
+	}
+	if len(MorrisMoves(board, toMove)) == 0 {
+		return OppositeSymbol(toMove), false
+	}
+
+	if len(history) >= MorrisMaxPlies {
+		return models.SymbolEmpty, true
+	}
+	if len(history) > 0 {
+		last, seen := history[len(history)-1], 0
+		for _, key := range history {
+			if key == last {
+				seen++
+			}
+		}
+		if seen >= MorrisRepetitions {
+			return models.SymbolEmpty, true
+		}
+	}
+	return models.SymbolEmpty, false
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import (
+	"testing"
+
+	"tic-tac-go/internal/models"
+)
+
+func TestIsAdjacent(t *testing.T) {
+	tests := []struct {
+		name                           string
+		fromRow, fromCol, toRow, toCol int
+		want                           bool
+	}{
+		{"horizontal neighbour", 0, 0, 0, 1, true},
+		{"vertical neighbour", 0, 0, 1, 0, true},
+		{"corner to centre", 0, 0, 1, 1, true},
+		{"centre to corner", 1, 1, 2, 0, true},
+		{"edge to edge diagonal", 0, 1, 1, 0, false},
+		{"two cells away", 0, 0, 0, 2, false},
+		{"same cell", 1, 1, 1, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IsAdjacent(tt.fromRow, tt.fromCol, tt.toRow, tt.toCol)
+			if got != tt.want {
+				t.Fatalf("IsAdjacent(%d,%d -> %d,%d) = %v, want %v", tt.fromRow, tt.fromCol, tt.toRow, tt.toCol, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplySlide_ValidAndInvalid(t *testing.T) {
+	board := NewBoard()
+	board[0][0] = models.SymbolX
+	board[2][2] = models.SymbolO
+
+	newBoard, err := ApplySlide(board, [2]int{0, 0}, [2]int{0, 1}, models.SymbolX)
+	if err != nil {
+		t.Fatalf("expected no error for valid slide, got %v", err)
+	}
+	if newBoard[0][0] != models.SymbolEmpty || newBoard[0][1] != models.SymbolX {
+		t.Fatalf("expected piece moved from (0,0) to (0,1), got %v", newBoard)
+	}
+	if board[0][0] != models.SymbolX {
+		t.Fatalf("expected original board to be unchanged")
+	}
+
+	// opponent's piece
+	if _, err := ApplySlide(board, [2]int{2, 2}, [2]int{2, 1}, models.SymbolX); err == nil {
+		t.Fatalf("expected error when sliding an opponent piece")
+	}
+	// not adjacent
+	if _, err := ApplySlide(board, [2]int{0, 0}, [2]int{2, 0}, models.SymbolX); err == nil {
+		t.Fatalf("expected error when sliding to a non-adjacent cell")
+	}
+}
+
+func TestMorrisMoves_PlacementThenSlides(t *testing.T) {
+	board := NewBoard()
+	board[0][0] = models.SymbolX
+	board[0][1] = models.SymbolX
+
+	// Two pieces placed: X still places, one move per empty cell.
+	if got := len(MorrisMoves(board, models.SymbolX)); got != 7 {
+		t.Fatalf("expected 7 placements, got %d", got)
+	}
+
+	board[2][1] = models.SymbolX
+	for _, move := range MorrisMoves(board, models.SymbolX) {
+		if move.From == nil {
+			t.Fatalf("expected only slides once all pieces are placed, got placement %+v", move)
+		}
+	}
+}
+
+func TestMorrisResult_RepetitionDraw(t *testing.T) {
+	board := NewBoard()
+	board[0][0] = models.SymbolX
+	board[0][2] = models.SymbolX
+	board[2][1] = models.SymbolX
+	board[1][0] = models.SymbolO
+	board[1][2] = models.SymbolO
+	board[2][2] = models.SymbolO
+
+	key := PositionKey(board, models.SymbolX)
+	history := []string{key, "a", key, "b", key}
+
+	winner, isDraw := MorrisResult(board, models.SymbolX, history, false)
+	if winner != models.SymbolEmpty || !isDraw {
+		t.Fatalf("expected draw by repetition, got winner=%q isDraw=%v", winner, isDraw)
+	}
+
+	winner, isDraw = MorrisResult(board, models.SymbolX, history[:3], false)
+	if winner != models.SymbolEmpty || isDraw {
+		t.Fatalf("expected game to continue after two repetitions, got winner=%q isDraw=%v", winner, isDraw)
+	}
+}
@@ -0,0 +1,418 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"tic-tac-go/internal/models"
+)
+
+// ErrInvalidNotation is returned when a position, move or game record cannot be parsed.
+var ErrInvalidNotation = errors.New("invalid notation")
+
+// Result values used in game records, following the PGN convention.
+const (
+	RecordResultXWins   = "1-0"
+	RecordResultOWins   = "0-1"
+	RecordResultDraw    = "1/2-1/2"
+	RecordResultOngoing = "*"
+)
+
+// recordDateLayout is the date format of the Date header, e.g. "2026.10.18".
+const recordDateLayout = "2006.01.02"
+
+// GameRecord is a portable record of a game: its headers and the moves played.
+type GameRecord struct {
+	GameID  string
+	Date    time.Time
+	Mode    models.GameMode
+	Variant models.GameVariant
+	PlayerX string
+	PlayerO string
+	// Result is one of the RecordResult constants
+	Result string
+	// ForfeitedBy is the seat that lost by forfeit, e.g. a bot that did not
+	// answer or a player who ran out of time; empty otherwise
+	ForfeitedBy models.Symbol
+	Torus       bool
+	// XRole is seat X's role in asymmetric variants; seat O has the other role
+	XRole models.Role
+	// Setup is the starting board if it differs from the empty board
+	// (e.g. blocked cells); nil otherwise
+	Setup models.Board
+	Moves []models.MoveRecord
+}
+
+// FormatPosition encodes a board and the side to move as a compact string,
+// e.g. "XO./.X./..O x". Rows are separated by '/', empty cells are '.', blocked
+// cells '#', and the side to move is written in lower case ('-' if nobody is to move).
+func FormatPosition(board models.Board, toMove models.Symbol) string {
+	side := "-"
+	if toMove != models.SymbolEmpty {
+		side = strings.ToLower(string(toMove))
+	}
+	return strings.ReplaceAll(BoardKey(board), "|", "/") + " " + side
+}
+
+// ParsePosition decodes a position string written by FormatPosition.
+// The board must be square and every cell a single character.
+func ParsePosition(s string) (models.Board, models.Symbol, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return nil, "", fmt.Errorf("%w: position %q needs a board and a side to move", ErrInvalidNotation, s)
+	}
+
+	rows := strings.Split(fields[0], "/")
+	board := NewBoardSize(len(rows))
+	for r, row := range rows {
+		if len(row) != len(rows) {
+			return nil, "", fmt.Errorf("%w: position %q is not square", ErrInvalidNotation, s)
+		}
+		for c, ch := range row {
+			cell, err := parseCellSymbol(ch)
+			if err != nil {
+				return nil, "", err
+			}
+			board[r][c] = cell
+		}
+	}
+
+	var toMove models.Symbol
+	switch fields[1] {
+	case "x":
+		toMove = models.SymbolX
+	case "o":
+		toMove = models.SymbolO
+	case "-":
+		toMove = models.SymbolEmpty
+	default:
+		return nil, "", fmt.Errorf("%w: unknown side to move %q", ErrInvalidNotation, fields[1])
+	}
+	return board, toMove, nil
+}
+
+// parseCellSymbol decodes a single board cell of a position string.
+func parseCellSymbol(ch rune) (models.Symbol, error) {
+	switch {
+	case ch == '.':
+		return models.SymbolEmpty, nil
+	case ch == 'X' || ch == 'O' || ch == '#':
+		return models.Symbol(ch), nil
+	case ch >= '1' && ch <= '9':
+		return models.Symbol(ch), nil
+	default:
+		return "", fmt.Errorf("%w: unknown cell %q", ErrInvalidNotation, ch)
+	}
+}
+
+// FormatCell writes a cell as a column letter and a 1-based row number,
+// e.g. row 0, col 1 is "b1".
+func FormatCell(row, col int) string {
+	return string(rune('a'+col)) + strconv.Itoa(row+1)
+}
+
+// ParseCell decodes a cell written by FormatCell.
+func ParseCell(s string) (row, col int, err error) {
+	if len(s) < 2 || s[0] < 'a' || s[0] > 'z' {
+		return 0, 0, fmt.Errorf("%w: invalid cell %q", ErrInvalidNotation, s)
+	}
+	n, err := strconv.Atoi(s[1:])
+	if err != nil || n < 1 {
+		return 0, 0, fmt.Errorf("%w: invalid cell %q", ErrInvalidNotation, s)
+	}
+	return n - 1, int(s[0] - 'a'), nil
+}
+
+// FormatMove writes a move in record notation: "b2" for a placement,
+// "b2=O" or "b2=7" when the move names a mark or number and "a1-b2" for a slide.
+func FormatMove(move models.Move) string {
+	s := FormatCell(move.Row, move.Col)
+	if move.From != nil {
+		s = FormatCell(move.From[0], move.From[1]) + "-" + s
+	}
+	switch {
+	case move.Number != 0:
+		s += "=" + strconv.Itoa(move.Number)
+	case move.Symbol != models.SymbolEmpty:
+		s += "=" + string(move.Symbol)
+	}
+	return s
+}
+
+// ParseMove decodes a move written by FormatMove.
+func ParseMove(s string) (models.Move, error) {
+	var move models.Move
+
+	target, mark, hasMark := strings.Cut(s, "=")
+	if hasMark {
+		if mark == string(models.SymbolX) || mark == string(models.SymbolO) {
+			move.Symbol = models.Symbol(mark)
+		} else if n, err := strconv.Atoi(mark); err == nil && n >= 1 && n <= 9 {
+			move.Number = n
+		} else {
+			return move, fmt.Errorf("%w: invalid mark in move %q", ErrInvalidNotation, s)
+		}
+	}
+
+	if from, to, isSlide := strings.Cut(target, "-"); isSlide {
+		r, c, err := ParseCell(from)
+		if err != nil {
+			return move, err
+		}
+		move.From = &[2]int{r, c}
+		target = to
+	}
+
+	r, c, err := ParseCell(target)
+	if err != nil {
+		return move, err
+	}
+	move.Row, move.Col = r, c
+	return move, nil
+}
+
+// RecordResult converts a game's winner into a record result.
+func RecordResult(state *models.GameState) string {
+	if state.Status != models.GameStatusFinished {
+		return RecordResultOngoing
+	}
+	switch state.Winner {
+	case string(models.SymbolX):
+		return RecordResultXWins
+	case string(models.SymbolO):
+		return RecordResultOWins
+	default:
+		return RecordResultDraw
+	}
+}
+
+// NewGameRecord builds the record of a game; playerX and playerO are the
+// names written to the X and O headers.
+func NewGameRecord(state *models.GameState, playerX, playerO string) *GameRecord {
+	record := &GameRecord{
+		GameID:  state.ID,
+		Date:    state.CreatedAt,
+		Mode:    state.Mode,
+		Variant: state.Variant,
+		PlayerX: playerX,
+		PlayerO: playerO,
+		Result:  RecordResult(state),
+		Torus:   state.Torus,
+		XRole:   state.Roles[models.SymbolX],
+		Moves:   state.History,
+	}
+	if state.Status == models.GameStatusFinished {
+		record.ForfeitedBy = state.ForfeitedBy
+	}
+
+	// Blocked cells never change, so they describe the starting board.
+	if CountSymbol(state.Board, models.SymbolBlocked) > 0 {
+		setup := NewBoardSize(len(state.Board))
+		for r := range state.Board {
+			for c := range state.Board[r] {
+				if state.Board[r][c] == models.SymbolBlocked {
+					setup[r][c] = models.SymbolBlocked
+				}
+			}
+		}
+		record.Setup = setup
+	}
+	return record
+}
+
+// FormatRecord writes a game record in a PGN-like text format: one
+// [Key "Value"] header per line, a blank line, then the numbered move list
+// followed by the result, e.g.
+//
+//	[Variant "CLASSIC"]
+//	...
+//
+//	1. b2 a1 2. c3 a3 3. a2 c2 4. b1 b3 5. c1 1/2-1/2
+func FormatRecord(record *GameRecord) string {
+	var sb strings.Builder
+	header := func(key, value string) {
+		fmt.Fprintf(&sb, "[%s %q]\n", key, value)
+	}
+
+	header("Game", record.GameID)
+	header("Date", record.Date.Format(recordDateLayout))
+	header("Mode", string(record.Mode))
+	header("Variant", string(record.Variant))
+	header("X", record.PlayerX)
+	header("O", record.PlayerO)
+	header("Result", record.Result)
+	if record.ForfeitedBy != "" {
+		header("ForfeitedBy", string(record.ForfeitedBy))
+	}
+	if record.Torus {
+		header("Torus", "true")
+	}
+	if record.XRole != "" {
+		header("XRole", string(record.XRole))
+	}
+	if record.Setup != nil {
+		header("Setup", FormatPosition(record.Setup, models.SymbolX))
+	}
+	sb.WriteByte('\n')
+
+	for i, m := range record.Moves {
+		if i%2 == 0 {
+			if i > 0 {
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(strconv.Itoa(i/2+1) + ". ")
+		} else {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(FormatMove(m.Move))
+	}
+	if len(record.Moves) > 0 {
+		sb.WriteByte(' ')
+	}
+	sb.WriteString(record.Result)
+	sb.WriteByte('\n')
+	return sb.String()
+}
+
+// ParseRecord decodes a game record written by FormatRecord. Unknown headers
+// are ignored; moves are assigned to seats alternately, starting with X.
+// Missing Mode and Variant headers default to PVP and CLASSIC.
+func ParseRecord(s string) (*GameRecord, error) {
+	record := &GameRecord{
+		Mode:    models.GameModePVP,
+		Variant: models.GameVariantClassic,
+		Result:  RecordResultOngoing,
+	}
+	headers := make(map[string]string)
+
+	var moveText []string
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			key, value, err := parseHeader(line)
+			if err != nil {
+				return nil, err
+			}
+			headers[key] = value
+			continue
+		}
+		moveText = append(moveText, line)
+	}
+
+	for key, value := range headers {
+		switch key {
+		case "Game":
+			record.GameID = value
+		case "Date":
+			date, err := time.Parse(recordDateLayout, value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid date %q", ErrInvalidNotation, value)
+			}
+			record.Date = date
+		case "Mode":
+			record.Mode = models.GameMode(value)
+		case "Variant":
+			record.Variant = models.GameVariant(value)
+		case "X":
+			record.PlayerX = value
+		case "O":
+			record.PlayerO = value
+		case "Result":
+			record.Result = value
+		case "ForfeitedBy":
+			record.ForfeitedBy = models.Symbol(value)
+		case "Torus":
+			record.Torus = value == "true"
+		case "XRole":
+			record.XRole = models.Role(value)
+		case "Setup":
+			setup, _, err := ParsePosition(value)
+			if err != nil {
+				return nil, err
+			}
+			record.Setup = setup
+		}
+	}
+	if !isRecordResult(record.Result) {
+		return nil, fmt.Errorf("%w: invalid result %q", ErrInvalidNotation, record.Result)
+	}
+	// The seat that forfeited must be the loser.
+	switch {
+	case record.ForfeitedBy == "":
+	case record.ForfeitedBy == models.SymbolX && record.Result == RecordResultOWins:
+	case record.ForfeitedBy == models.SymbolO && record.Result == RecordResultXWins:
+	default:
+		return nil, fmt.Errorf("%w: forfeit by %q does not match result %q", ErrInvalidNotation, record.ForfeitedBy, record.Result)
+	}
+
+	seat := models.SymbolX
+	for _, token := range strings.Fields(strings.Join(moveText, " ")) {
+		if strings.HasSuffix(token, ".") {
+			continue // move number
+		}
+		if isRecordResult(token) {
+			if token != record.Result {
+				return nil, fmt.Errorf("%w: result %q does not match header %q", ErrInvalidNotation, token, record.Result)
+			}
+			continue
+		}
+		move, err := ParseMove(token)
+		if err != nil {
+			return nil, err
+		}
+		record.Moves = append(record.Moves, models.MoveRecord{Seat: seat, Move: move})
+		seat = OppositeSymbol(seat)
+	}
+	return record, nil
+}
+
+// parseHeader decodes a single [Key "Value"] header line.
+func parseHeader(line string) (key, value string, err error) {
+	inner, ok := strings.CutSuffix(strings.TrimPrefix(line, "["), "]")
+	if !ok {
+		return "", "", fmt.Errorf("%w: invalid header %q", ErrInvalidNotation, line)
+	}
+	key, quoted, ok := strings.Cut(inner, " ")
+	if !ok {
+		return "", "", fmt.Errorf("%w: invalid header %q", ErrInvalidNotation, line)
+	}
+	value, err = strconv.Unquote(strings.TrimSpace(quoted))
+	if err != nil {
+		return "", "", fmt.Errorf("%w: invalid header %q", ErrInvalidNotation, line)
+	}
+	return key, value, nil
+}
+
+// isRecordResult reports whether s is one of the RecordResult constants.
+func isRecordResult(s string) bool {
+	switch s {
+	case RecordResultXWins, RecordResultOWins, RecordResultDraw, RecordResultOngoing:
+		return true
+	default:
+		return false
+	}
+}
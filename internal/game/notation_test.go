@@ -0,0 +1,151 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import (
+	"testing"
+	"time"
+
+	"tic-tac-go/internal/models"
+)
+
+func TestFormatPosition_RoundTrip(t *testing.T) {
+	board := NewBoard()
+	board[0][0] = models.SymbolX
+	board[0][1] = models.SymbolO
+	board[1][1] = models.SymbolX
+	board[2][2] = models.SymbolO
+	board[2][0] = models.SymbolBlocked
+
+	s := FormatPosition(board, models.SymbolX)
+	if s != "XO./.X./#.O x" {
+		t.Fatalf("unexpected position string %q", s)
+	}
+
+	parsed, toMove, err := ParsePosition(s)
+	if err != nil {
+		t.Fatalf("ParsePosition error = %v", err)
+	}
+	if toMove != models.SymbolX || BoardKey(parsed) != BoardKey(board) {
+		t.Fatalf("round trip mismatch: %s %s", BoardKey(parsed), toMove)
+	}
+}
+
+func TestParsePosition_Invalid(t *testing.T) {
+	for _, s := range []string{"", "XO./.X. x", "XO./.X./..Z x", "XO./.X./..O y"} {
+		if _, _, err := ParsePosition(s); err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
+
+func TestFormatMove_RoundTrip(t *testing.T) {
+	moves := []models.Move{
+		{Row: 1, Col: 1},
+		{Row: 0, Col: 2, Symbol: models.SymbolO},
+		{Row: 2, Col: 0, Number: 7},
+		{Row: 1, Col: 0, From: &[2]int{0, 0}},
+	}
+	want := []string{"b2", "c1=O", "a3=7", "a1-a2"}
+
+	for i, m := range moves {
+		s := FormatMove(m)
+		if s != want[i] {
+			t.Errorf("expected %q, got %q", want[i], s)
+		}
+		parsed, err := ParseMove(s)
+		if err != nil {
+			t.Fatalf("ParseMove(%q) error = %v", s, err)
+		}
+		if FormatMove(parsed) != s {
+			t.Errorf("round trip of %q gave %q", s, FormatMove(parsed))
+		}
+	}
+}
+
+func TestFormatRecord_RoundTrip(t *testing.T) {
+	setup := NewBoard()
+	setup[0][2] = models.SymbolBlocked
+	record := &GameRecord{
+		GameID:  "g1",
+		Date:    time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		Mode:    models.GameModePVP,
+		Variant: models.GameVariantClassic,
+		PlayerX: "Alice",
+		PlayerO: "Bob",
+		Result:  RecordResultXWins,
+		Torus:   true,
+		Setup:   setup,
+		Moves: []models.MoveRecord{
+			{Seat: models.SymbolX, Move: models.Move{Row: 1, Col: 1}},
+			{Seat: models.SymbolO, Move: models.Move{Row: 0, Col: 0}},
+			{Seat: models.SymbolX, Move: models.Move{Row: 2, Col: 2}},
+		},
+	}
+
+	text := FormatRecord(record)
+	parsed, err := ParseRecord(text)
+	if err != nil {
+		t.Fatalf("ParseRecord error = %v\n%s", err, text)
+	}
+
+	if parsed.GameID != "g1" || parsed.PlayerX != "Alice" || parsed.PlayerO != "Bob" ||
+		parsed.Result != RecordResultXWins || !parsed.Torus || !parsed.Date.Equal(record.Date) {
+		t.Fatalf("headers not preserved: %+v", parsed)
+	}
+	if parsed.Setup == nil || parsed.Setup[0][2] != models.SymbolBlocked {
+		t.Fatalf("expected setup with blocked cell, got %v", parsed.Setup)
+	}
+	if len(parsed.Moves) != 3 || parsed.Moves[1].Seat != models.SymbolO || parsed.Moves[2].Row != 2 {
+		t.Fatalf("moves not preserved: %+v", parsed.Moves)
+	}
+	if FormatRecord(parsed) != text {
+		t.Fatalf("expected identical text after round trip")
+	}
+}
+
+func TestParseRecord_ResultMismatch(t *testing.T) {
+	text := "[Result \"1-0\"]\n\n1. b2 a1 0-1\n"
+	if _, err := ParseRecord(text); err == nil {
+		t.Fatalf("expected error for mismatched result")
+	}
+}
+
+func TestFormatRecord_Forfeit(t *testing.T) {
+	record := &GameRecord{
+		Mode:        models.GameModePVP,
+		Variant:     models.GameVariantClassic,
+		Result:      RecordResultXWins,
+		ForfeitedBy: models.SymbolO,
+		Moves:       []models.MoveRecord{{Seat: models.SymbolX, Move: models.Move{Row: 1, Col: 1}}},
+	}
+
+	parsed, err := ParseRecord(FormatRecord(record))
+	if err != nil {
+		t.Fatalf("ParseRecord error = %v", err)
+	}
+	if parsed.ForfeitedBy != models.SymbolO || parsed.Result != RecordResultXWins {
+		t.Fatalf("expected O to have forfeited a 1-0 game, got %+v", parsed)
+	}
+
+	text := "[Result \"1-0\"]\n[ForfeitedBy \"X\"]\n\n1. b2 1-0\n"
+	if _, err := ParseRecord(text); err == nil {
+		t.Fatalf("expected error for a forfeit by the winner")
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import "tic-tac-go/internal/models"
+
+// NumericalTarget is the sum a line of three numbers needs to win the numerical variant
+const NumericalTarget = 15
+
+// NumbersForSeat returns the numbers a seat may place in the numerical variant:
+// X (moving first) places the odd numbers, O the even numbers.
+func NumbersForSeat(seat models.Symbol) []int {
+	switch seat {
+	case models.SymbolX:
+		return []int{1, 3, 5, 7, 9}
+	case models.SymbolO:
+		return []int{2, 4, 6, 8}
+	default:
+		return nil
+	}
+}
+
+// AvailableNumbers returns the numbers the seat has not yet placed on the board
+func AvailableNumbers(board models.Board, seat models.Symbol) []int {
+	var numbers []int
+	for _, n := range NumbersForSeat(seat) {
+		if CountSymbol(board, models.NumberSymbol(n)) == 0 {
+			numbers = append(numbers, n)
+		}
+	}
+	return numbers
+}
+
+// IsNumberAvailable reports whether the seat may still place the number n
+func IsNumberAvailable(board models.Board, seat models.Symbol, n int) bool {
+	for _, available := range AvailableNumbers(board, seat) {
+		if available == n {
+			return true
+		}
+	}
+	return false
+}
+
+// HasNumericalLine reports whether a full row, column or diagonal sums to NumericalTarget.
+// If torus is true, lines wrap around the edges of the board.
+func HasNumericalLine(board models.Board, torus bool) bool {
+	size := len(board)
+	for _, line := range Lines(size, size, torus) {
+		sum, full := 0, true
+		for _, cell := range line {
+			value, ok := board[cell[0]][cell[1]].Number()
+			if !ok {
+				full = false
+				break
+			}
+			sum += value
+		}
+		if full && sum == NumericalTarget {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import (
+	"testing"
+
+	"tic-tac-go/internal/models"
+)
+
+func TestHasNumericalLine(t *testing.T) {
+	board := NewBoard()
+	board[0][0] = models.NumberSymbol(8)
+	board[1][1] = models.NumberSymbol(5)
+
+	if HasNumericalLine(board, false) {
+		t.Fatalf("expected no line while the diagonal is incomplete")
+	}
+
+	board[2][2] = models.NumberSymbol(2)
+	if !HasNumericalLine(board, false) {
+		t.Fatalf("expected 8+5+2 on the diagonal to be a winning line")
+	}
+}
+
+func TestAvailableNumbers(t *testing.T) {
+	board := NewBoard()
+	board[0][0] = models.NumberSymbol(5)
+	board[0][1] = models.NumberSymbol(4)
+
+	got := AvailableNumbers(board, models.SymbolX)
+	want := []int{1, 3, 7, 9}
+	if len(got) != len(want) {
+		t.Fatalf("AvailableNumbers(X) = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("AvailableNumbers(X) = %v, want %v", got, want)
+		}
+	}
+
+	if IsNumberAvailable(board, models.SymbolO, 4) {
+		t.Fatalf("expected 4 to be used up for O")
+	}
+	if IsNumberAvailable(board, models.SymbolO, 3) {
+		t.Fatalf("expected odd numbers to be unavailable for O")
+	}
+}
+
+func TestOutcome_WildMoverWins(t *testing.T) {
+	// O completes a line of X marks and wins.
+	state := &models.GameState{
+		Variant:     models.GameVariantWild,
+		Board:       NewBoard(),
+		CurrentTurn: models.SymbolO,
+	}
+	state.Board[2][0] = models.SymbolX
+	state.Board[2][1] = models.SymbolX
+	state.Board[2][2] = models.SymbolX
+
+	winner, isDraw := Outcome(state)
+	if winner != models.SymbolO || isDraw {
+		t.Fatalf("expected O to win, got winner=%q isDraw=%v", winner, isDraw)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import "tic-tac-go/internal/models"
+
+const (
+	// OrderChaosSize is the number of rows and columns of an Order and Chaos board
+	OrderChaosSize = 6
+	// OrderChaosLine is the number of equal marks in a row ORDER needs to win
+	OrderChaosLine = 5
+)
+
+// OppositeRole returns the other role of an asymmetric variant (so ORDER <--> CHAOS)
+func OppositeRole(role models.Role) models.Role {
+	if role == models.RoleOrder {
+		return models.RoleChaos
+	}
+	return models.RoleOrder
+}
+
+// OrderChaosResult checks an Order and Chaos board and returns:
+//   - winner: RoleOrder if five equal marks are in a row, RoleChaos if the
+//     board is full without such a line.
+//   - finished: false while neither side has won yet.
+//
+// If torus is true, lines wrap around the edges of the board.
+func OrderChaosResult(board models.Board, torus bool) (winner models.Role, finished bool) {
+	if LineWinner(board, OrderChaosLine, torus) != models.SymbolEmpty {
+		return models.RoleOrder, true
+	}
+	if IsFull(board) {
+		return models.RoleChaos, true
+	}
+	return "", false
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import (
+	"testing"
+
+	"tic-tac-go/internal/models"
+)
+
+func TestOrderChaosResult_FiveInARowWinsForOrder(t *testing.T) {
+	board := NewBoardSize(OrderChaosSize)
+	for col := 1; col <= 5; col++ {
+		board[2][col] = models.SymbolO
+	}
+
+	winner, finished := OrderChaosResult(board, false)
+	if !finished || winner != models.RoleOrder {
+		t.Fatalf("expected ORDER to win, got winner=%q finished=%v", winner, finished)
+	}
+}
+
+func TestOrderChaosResult_FullBoardWinsForChaos(t *testing.T) {
+	// Alternate marks in pairs so no row, column or diagonal has five equal marks.
+	board := NewBoardSize(OrderChaosSize)
+	for row := 0; row < OrderChaosSize; row++ {
+		for col := 0; col < OrderChaosSize; col++ {
+			if (row/2+col)%2 == 0 {
+				board[row][col] = models.SymbolX
+			} else {
+				board[row][col] = models.SymbolO
+			}
+		}
+	}
+
+	winner, finished := OrderChaosResult(board, false)
+	if !finished || winner != models.RoleChaos {
+		t.Fatalf("expected CHAOS to win, got winner=%q finished=%v", winner, finished)
+	}
+}
+
+func TestOrderChaosResult_FourInARowIsNotEnough(t *testing.T) {
+	board := NewBoardSize(OrderChaosSize)
+	for i := 0; i < 4; i++ {
+		board[i][i] = models.SymbolX
+	}
+
+	if _, finished := OrderChaosResult(board, false); finished {
+		t.Fatalf("expected game to continue with only four in a row")
+	}
+}
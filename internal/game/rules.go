@@ -18,46 +18,120 @@
 
 package game
 
-import "tic-tac-go/internal/models"
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"tic-tac-go/internal/models"
+)
+
+// lineDirections are the (row, col) steps of the four line orientations:
+// horizontal, vertical, diagonal and anti-diagonal.
+var lineDirections = [4][2]int{{0, 1}, {1, 0}, {1, 1}, {1, -1}}
+
+// lineCache memoises Lines results keyed by lineKey.
+var lineCache sync.Map
+
+type lineKey struct {
+	size, length int
+	torus        bool
+}
 
 // CheckWinner checks the board and returns:
-//   - winner: "X" or "O" if someone has three in a row,
-//     models.SymbolEmpty ("") otherwise.
+//   - winner: "X" or "O" if someone has a complete row, column or diagonal
+//     (three in a row on the classic 3x3 board), models.SymbolEmpty ("") otherwise.
 //   - isDraw: true if the board is full and there is no winner.
 func CheckWinner(board models.Board) (winner models.Symbol, isDraw bool) {
-	// 1. Check rows and columns
-	for i := 0; i < 3; i++ {
-		// Row i
-		if board[i][0] != models.SymbolEmpty &&
-			board[i][0] == board[i][1] &&
-			board[i][1] == board[i][2] {
-			return board[i][0], false
-		}
-		// Column i
-		if board[0][i] != models.SymbolEmpty &&
-			board[0][i] == board[1][i] &&
-			board[1][i] == board[2][i] {
-			return board[0][i], false
-		}
-	}
+	return CheckWinnerWithTorus(board, false)
+}
 
-	// 2. Diagonals
-	if board[0][0] != models.SymbolEmpty &&
-		board[0][0] == board[1][1] &&
-		board[1][1] == board[2][2] {
-		return board[0][0], false
-	}
-	if board[0][2] != models.SymbolEmpty &&
-		board[0][2] == board[1][1] &&
-		board[1][1] == board[2][0] {
-		return board[0][2], false
+// CheckWinnerWithTorus works like CheckWinner; if torus is true, rows, columns
+// and diagonals wrap around the edges of the board.
+func CheckWinnerWithTorus(board models.Board, torus bool) (winner models.Symbol, isDraw bool) {
+	// 1. Check rows, columns and diagonals
+	if winner := LineWinner(board, len(board), torus); winner != models.SymbolEmpty {
+		return winner, false
 	}
 
-	// 3. Draw?
+	// 2. Draw?
 	if IsFull(board) {
 		return models.SymbolEmpty, true
 	}
 
-	// 4. Game still in progress
+	// 3. Game still in progress
 	return models.SymbolEmpty, false
 }
+
+// LineWinner returns the symbol that occupies length consecutive cells in a
+// row, column or diagonal, or models.SymbolEmpty if there is no such line.
+// Blocked cells never form a line.
+func LineWinner(board models.Board, length int, torus bool) models.Symbol {
+	for _, line := range Lines(len(board), length, torus) {
+		first := board[line[0][0]][line[0][1]]
+		if first == models.SymbolEmpty || first == models.SymbolBlocked {
+			continue
+		}
+		n := 1
+		for n < length && board[line[n][0]][line[n][1]] == first {
+			n++
+		}
+		if n == length {
+			return first
+		}
+	}
+	return models.SymbolEmpty
+}
+
+// Lines returns every line of length consecutive cells on a size x size board
+// as lists of [row, col] pairs. If torus is true, lines wrap around the edges,
+// so e.g. (0,1), (1,2), (2,0) is a diagonal of a 3x3 torus. The result is
+// shared between callers and must not be modified.
+func Lines(size, length int, torus bool) [][][2]int {
+	key := lineKey{size: size, length: length, torus: torus}
+	if cached, ok := lineCache.Load(key); ok {
+		return cached.([][][2]int)
+	}
+
+	var lines [][][2]int
+	seen := make(map[string]bool)
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			for _, dir := range lineDirections {
+				endRow, endCol := row+dir[0]*(length-1), col+dir[1]*(length-1)
+				if !torus && (endRow < 0 || endRow >= size || endCol < 0 || endCol >= size) {
+					continue
+				}
+
+				line := make([][2]int, length)
+				for n := 0; n < length; n++ {
+					r := ((row+dir[0]*n)%size + size) % size
+					c := ((col+dir[1]*n)%size + size) % size
+					line[n] = [2]int{r, c}
+				}
+
+				// On a torus a full-length line is found once per starting cell.
+				id := lineID(line)
+				if seen[id] {
+					continue
+				}
+				seen[id] = true
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	lineCache.Store(key, lines)
+	return lines
+}
+
+// lineID identifies a line by its set of cells, independent of the start cell.
+func lineID(line [][2]int) string {
+	cells := make([]string, len(line))
+	for i, cell := range line {
+		cells[i] = fmt.Sprintf("%d,%d", cell[0], cell[1])
+	}
+	sort.Strings(cells)
+	return strings.Join(cells, ";")
+}
@@ -59,3 +59,43 @@ func TestCheckWinner_Draw(t *testing.T) {
 		t.Fatalf("expected draw with no winner, got winner=%q isDraw=%v", winner, isDraw)
 	}
 }
+
+func TestCheckWinnerWithTorus_WrappedDiagonal(t *testing.T) {
+	// Broken diagonal (0,1), (1,2), (2,0):
+	// . X .
+	// . . X
+	// X . .
+	board := NewBoard()
+	board[0][1] = models.SymbolX
+	board[1][2] = models.SymbolX
+	board[2][0] = models.SymbolX
+
+	if winner, _ := CheckWinner(board); winner != models.SymbolEmpty {
+		t.Fatalf("expected no winner on a flat board, got %q", winner)
+	}
+	if winner, _ := CheckWinnerWithTorus(board, true); winner != models.SymbolX {
+		t.Fatalf("expected X to win on a torus, got %q", winner)
+	}
+}
+
+func TestLines_TorusHasNoDuplicates(t *testing.T) {
+	// A 3x3 torus has 3 rows, 3 columns and 3 lines along each diagonal direction.
+	if got := len(Lines(3, 3, true)); got != 12 {
+		t.Fatalf("expected 12 torus lines, got %d", got)
+	}
+	if got := len(Lines(3, 3, false)); got != 8 {
+		t.Fatalf("expected 8 flat lines, got %d", got)
+	}
+}
+
+func TestCheckWinner_BlockedCellsNeverWin(t *testing.T) {
+	board := NewBoard()
+	board[0][0] = models.SymbolBlocked
+	board[0][1] = models.SymbolBlocked
+	board[0][2] = models.SymbolBlocked
+
+	winner, isDraw := CheckWinner(board)
+	if winner != models.SymbolEmpty || isDraw {
+		t.Fatalf("expected game in progress, got winner=%q isDraw=%v", winner, isDraw)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import "tic-tac-go/internal/models"
+
+// Symmetry is one of the 8 rotations and reflections of a square board.
+type Symmetry int
+
+const (
+	Identity Symmetry = iota
+	Rotate90
+	Rotate180
+	Rotate270
+	MirrorHorizontal   // mirror left/right
+	MirrorVertical     // mirror top/bottom
+	MirrorDiagonal     // mirror along the main diagonal
+	MirrorAntiDiagonal // mirror along the anti-diagonal
+)
+
+// Symmetries lists all 8 symmetries of a square board, starting with Identity.
+var Symmetries = []Symmetry{
+	Identity, Rotate90, Rotate180, Rotate270,
+	MirrorHorizontal, MirrorVertical, MirrorDiagonal, MirrorAntiDiagonal,
+}
+
+// String returns the name of the symmetry.
+func (s Symmetry) String() string {
+	switch s {
+	case Identity:
+		return "IDENTITY"
+	case Rotate90:
+		return "ROTATE_90"
+	case Rotate180:
+		return "ROTATE_180"
+	case Rotate270:
+		return "ROTATE_270"
+	case MirrorHorizontal:
+		return "MIRROR_HORIZONTAL"
+	case MirrorVertical:
+		return "MIRROR_VERTICAL"
+	case MirrorDiagonal:
+		return "MIRROR_DIAGONAL"
+	case MirrorAntiDiagonal:
+		return "MIRROR_ANTI_DIAGONAL"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Inverse returns the symmetry that undoes s. Rotations by 90° and 270° undo
+// each other; every other symmetry is its own inverse.
+func (s Symmetry) Inverse() Symmetry {
+	switch s {
+	case Rotate90:
+		return Rotate270
+	case Rotate270:
+		return Rotate90
+	default:
+		return s
+	}
+}
+
+// TransformCell maps the cell (row, col) of a size x size board to its
+// position under symmetry s. Rotations are clockwise.
+func TransformCell(s Symmetry, size, row, col int) (int, int) {
+	last := size - 1
+	switch s {
+	case Rotate90:
+		return col, last - row
+	case Rotate180:
+		return last - row, last - col
+	case Rotate270:
+		return last - col, row
+	case MirrorHorizontal:
+		return row, last - col
+	case MirrorVertical:
+		return last - row, col
+	case MirrorDiagonal:
+		return col, row
+	case MirrorAntiDiagonal:
+		return last - col, last - row
+	default:
+		return row, col
+	}
+}
+
+// TransformBoard returns a copy of board transformed by symmetry s.
+func TransformBoard(board models.Board, s Symmetry) models.Board {
+	size := len(board)
+	out := NewBoardSize(size)
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			r, c := TransformCell(s, size, row, col)
+			out[r][c] = board[row][col]
+		}
+	}
+	return out
+}
+
+// TransformMove maps a move on a size x size board under symmetry s,
+// including the origin cell of sliding moves.
+func TransformMove(move models.Move, s Symmetry, size int) models.Move {
+	out := move
+	out.Row, out.Col = TransformCell(s, size, move.Row, move.Col)
+	if move.From != nil {
+		r, c := TransformCell(s, size, move.From[0], move.From[1])
+		out.From = &[2]int{r, c}
+	}
+	return out
+}
+
+// Canonical returns the canonical form of board among its 8 symmetries (the
+// one with the smallest BoardKey) together with the symmetry that produces it.
+// Equivalent positions have the same canonical form; a move found for the
+// canonical board maps back with TransformMove(move, s.Inverse(), size).
+func Canonical(board models.Board) (models.Board, Symmetry) {
+	best, bestSymmetry := board, Identity
+	bestKey := BoardKey(board)
+	for _, s := range Symmetries[1:] {
+		candidate := TransformBoard(board, s)
+		if key := BoardKey(candidate); key < bestKey {
+			best, bestSymmetry, bestKey = candidate, s, key
+		}
+	}
+	return CloneBoard(best), bestSymmetry
+}
+
+// CanonicalKey returns the BoardKey of the canonical form of board, so that
+// equivalent positions share one key.
+func CanonicalKey(board models.Board) string {
+	canonical, _ := Canonical(board)
+	return BoardKey(canonical)
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import (
+	"testing"
+
+	"tic-tac-go/internal/models"
+)
+
+func TestTransformCell_Rotate90(t *testing.T) {
+	// Clockwise: top-left corner goes to top-right, top-right to bottom-right.
+	if r, c := TransformCell(Rotate90, 3, 0, 0); r != 0 || c != 2 {
+		t.Fatalf("expected (0,2), got (%d,%d)", r, c)
+	}
+	if r, c := TransformCell(Rotate90, 3, 0, 2); r != 2 || c != 2 {
+		t.Fatalf("expected (2,2), got (%d,%d)", r, c)
+	}
+}
+
+func TestSymmetry_InverseUndoesTransform(t *testing.T) {
+	board := NewBoardSize(4)
+	board[0][1] = models.SymbolX
+	board[2][3] = models.SymbolO
+	board[3][0] = models.SymbolBlocked
+
+	for _, s := range Symmetries {
+		got := TransformBoard(TransformBoard(board, s), s.Inverse())
+		if BoardKey(got) != BoardKey(board) {
+			t.Errorf("%s: inverse did not restore the board, got %s", s, BoardKey(got))
+		}
+	}
+}
+
+func TestCanonical_EquivalentBoardsShareForm(t *testing.T) {
+	board := NewBoard()
+	board[0][0] = models.SymbolX
+	board[1][2] = models.SymbolO
+
+	want := CanonicalKey(board)
+	for _, s := range Symmetries {
+		if got := CanonicalKey(TransformBoard(board, s)); got != want {
+			t.Errorf("%s: expected canonical key %s, got %s", s, want, got)
+		}
+	}
+
+	other := NewBoard()
+	other[1][1] = models.SymbolX
+	if CanonicalKey(other) == want {
+		t.Fatalf("expected different positions to have different canonical keys")
+	}
+}
+
+func TestCanonical_MoveMapsBackWithInverse(t *testing.T) {
+	board := NewBoard()
+	board[2][1] = models.SymbolX
+
+	canonical, s := Canonical(board)
+	if BoardKey(canonical) != BoardKey(TransformBoard(board, s)) {
+		t.Fatalf("expected canonical board to be the board under %s", s)
+	}
+
+	// The X mark in the canonical board maps back to (2,1) in the original.
+	for row := range canonical {
+		for col := range canonical[row] {
+			if canonical[row][col] != models.SymbolX {
+				continue
+			}
+			move := TransformMove(models.Move{Row: row, Col: col, From: &[2]int{row, col}}, s.Inverse(), 3)
+			if move.Row != 2 || move.Col != 1 || move.From[0] != 2 || move.From[1] != 1 {
+				t.Fatalf("expected move to map back to (2,1), got %+v", move)
+			}
+		}
+	}
+}
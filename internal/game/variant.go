@@ -0,0 +1,169 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package game
+
+import (
+	"fmt"
+
+	"tic-tac-go/internal/models"
+)
+
+// BoardSize returns the number of rows (and columns) of the board used by a variant
+func BoardSize(variant models.GameVariant) int {
+	switch variant {
+	case models.GameVariantOrderAndChaos:
+		return OrderChaosSize
+	default:
+		return 3
+	}
+}
+
+// NewBoardForVariant creates the empty starting board for a variant
+func NewBoardForVariant(variant models.GameVariant) models.Board {
+	return NewBoardSize(BoardSize(variant))
+}
+
+// MarkForMove returns the mark a player sitting in seat places with the given move.
+// Classic seats always place their own symbol; in Order and Chaos and wild the move
+// must name X or O, and in the numerical variant it must name one of the seat's
+// numbers. The second result is false if the move does not carry a valid mark.
+func MarkForMove(variant models.GameVariant, seat models.Symbol, move models.Move) (models.Symbol, bool) {
+	switch variant {
+	case models.GameVariantOrderAndChaos, models.GameVariantWild:
+		if move.Symbol != models.SymbolX && move.Symbol != models.SymbolO {
+			return models.SymbolEmpty, false
+		}
+		return move.Symbol, true
+	case models.GameVariantNumerical:
+		if move.Symbol != models.SymbolEmpty {
+			return models.SymbolEmpty, false
+		}
+		for _, n := range NumbersForSeat(seat) {
+			if n == move.Number {
+				return models.NumberSymbol(n), true
+			}
+		}
+		return models.SymbolEmpty, false
+	default:
+		if move.Symbol != models.SymbolEmpty && move.Symbol != seat {
+			return models.SymbolEmpty, false
+		}
+		if move.Number != 0 {
+			return models.SymbolEmpty, false
+		}
+		return seat, true
+	}
+}
+
+// ApplyVariantMove returns a new board with the move of the player sitting in seat
+// applied under the rules of the game's variant. If the move is invalid, it returns an error.
+func ApplyVariantMove(state *models.GameState, seat models.Symbol, move models.Move) (models.Board, error) {
+	mark, ok := MarkForMove(state.Variant, seat, move)
+	if !ok {
+		return state.Board, fmt.Errorf("invalid mark %q for seat %s", move.Symbol, seat)
+	}
+
+	if state.Variant == models.GameVariantThreeMensMorris && !InPlacementPhase(state.Board, seat) {
+		if move.From == nil {
+			return state.Board, fmt.Errorf("all pieces placed, move must slide a piece")
+		}
+		return ApplySlide(state.Board, *move.From, [2]int{move.Row, move.Col}, seat)
+	}
+	if move.From != nil {
+		return state.Board, fmt.Errorf("move must place a new piece")
+	}
+	if state.Variant == models.GameVariantNumerical && !IsNumberAvailable(state.Board, seat, move.Number) {
+		return state.Board, fmt.Errorf("number %d has already been placed", move.Number)
+	}
+	return ApplyMove(state.Board, move.Row, move.Col, mark)
+}
+
+// Outcome evaluates the game according to its variant right after the player in
+// seat state.CurrentTurn has moved (before the turn passes) and returns:
+//   - winner: the seat ("X" or "O") that has won, models.SymbolEmpty otherwise.
+//   - isDraw: true if the game ended without a winner.
+func Outcome(state *models.GameState) (winner models.Symbol, isDraw bool) {
+	switch state.Variant {
+	case models.GameVariantOrderAndChaos:
+		role, finished := OrderChaosResult(state.Board, state.Torus)
+		if !finished {
+			return models.SymbolEmpty, false
+		}
+		return SeatForRole(state.Roles, role), false
+	case models.GameVariantThreeMensMorris:
+		return MorrisResult(state.Board, OppositeSymbol(state.CurrentTurn), state.PositionHistory, state.Torus)
+	case models.GameVariantWild, models.GameVariantNumerical:
+		// Lines do not belong to a seat, so whoever completes one wins.
+		if CompletesLine(state.Variant, state.Board, state.Torus) {
+			return state.CurrentTurn, false
+		}
+		return models.SymbolEmpty, IsFull(state.Board)
+	default:
+		return CheckWinnerWithTorus(state.Board, state.Torus)
+	}
+}
+
+// SeatForRole returns the seat that plays the given role, or models.SymbolEmpty if none does
+func SeatForRole(roles map[models.Symbol]models.Role, role models.Role) models.Symbol {
+	for seat, r := range roles {
+		if r == role {
+			return seat
+		}
+	}
+	return models.SymbolEmpty
+}
+
+// CompletesLine reports whether the board holds a winning line under the rules of
+// a variant where lines do not belong to a seat (wild and numerical). If torus is
+// true, lines wrap around the edges of the board.
+func CompletesLine(variant models.GameVariant, board models.Board, torus bool) bool {
+	if variant == models.GameVariantNumerical {
+		return HasNumericalLine(board, torus)
+	}
+	return LineWinner(board, len(board), torus) != models.SymbolEmpty
+}
+
+// LegalMoves returns every move the player in seat may make in the current
+// state, including the mark or number where the variant asks for one.
+// Fog-of-war games are treated like classic games on the full board.
+func LegalMoves(state *models.GameState, seat models.Symbol) []models.Move {
+	var moves []models.Move
+	switch state.Variant {
+	case models.GameVariantThreeMensMorris:
+		return MorrisMoves(state.Board, seat)
+	case models.GameVariantOrderAndChaos, models.GameVariantWild:
+		for _, cell := range AvailableMoves(state.Board) {
+			for _, mark := range []models.Symbol{models.SymbolX, models.SymbolO} {
+				moves = append(moves, models.Move{Row: cell[0], Col: cell[1], Symbol: mark})
+			}
+		}
+	case models.GameVariantNumerical:
+		numbers := AvailableNumbers(state.Board, seat)
+		for _, cell := range AvailableMoves(state.Board) {
+			for _, n := range numbers {
+				moves = append(moves, models.Move{Row: cell[0], Col: cell[1], Number: n})
+			}
+		}
+	default:
+		for _, cell := range AvailableMoves(state.Board) {
+			moves = append(moves, models.Move{Row: cell[0], Col: cell[1]})
+		}
+	}
+	return moves
+}
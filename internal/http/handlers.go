@@ -21,11 +21,14 @@ package http
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"log"
 	"net/http"
 	"strconv"
 	"time"
 
+	"tic-tac-go/internal/ai"
+	"tic-tac-go/internal/game"
 	"tic-tac-go/internal/models"
 	"tic-tac-go/internal/service"
 	"tic-tac-go/internal/store"
@@ -53,17 +56,225 @@ type createPlayerResponse struct {
 	Name     string `json:"name"`
 }
 
+// registerBotRequest registers an external engine as a player
+type registerBotRequest struct {
+	Name        string `json:"name"`
+	CallbackURL string `json:"callbackUrl"`
+}
+
+type registerBotResponse struct {
+	PlayerID    string `json:"playerId"`
+	Name        string `json:"name"`
+	CallbackURL string `json:"callbackUrl"`
+}
+
+// botDTO describes a computer player
+type botDTO struct {
+	PlayerID    string `json:"playerId"`
+	Name        string `json:"name"`
+	Rating      int    `json:"rating"`
+	Strategy    string `json:"strategy,omitempty"`
+	Personality string `json:"personality,omitempty"`
+	// External is true for engines registered through POST /bots
+	External bool `json:"external"`
+}
+
+type listBotsResponse struct {
+	Bots []botDTO `json:"bots"`
+}
+
 type createGameRequest struct {
-	Mode string `json:"mode"`
+	Mode    string `json:"mode"`
+	Variant string `json:"variant"`
+	// Role is the creator's role in ORDER_AND_CHAOS ("ORDER" or "CHAOS")
+	Role string `json:"role"`
+	// BlockedCells lists [row, col] cells that are permanently unavailable
+	BlockedCells [][2]int `json:"blockedCells"`
+	// RandomBlockedCells is the number of additional cells blocked at random
+	RandomBlockedCells int `json:"randomBlockedCells"`
+	// Torus makes lines wrap around the edges of the board
+	Torus bool `json:"torus"`
+	// DisableHints forbids hint requests in this game (e.g. rated games)
+	DisableHints bool `json:"disableHints"`
+	// AIStrategy selects the PVC opponent ("HEURISTIC" or "MCTS")
+	AIStrategy string `json:"aiStrategy"`
+	// OpponentAIStrategy is the strategy of seat O in CVC games (defaults to AIStrategy)
+	OpponentAIStrategy string `json:"opponentAiStrategy"`
+	// MovePaceMs is the pause before each move in CVC games (0 = default)
+	MovePaceMs int `json:"movePaceMs"`
+	// AIIterations and AITimeLimitMs bound the MCTS search (0 = default)
+	AIIterations  int `json:"aiIterations"`
+	AITimeLimitMs int `json:"aiTimeLimitMs"`
+	// Personality names a preset for a human-like PVC opponent (e.g. "CASUAL")
+	Personality string `json:"personality"`
+	// AdaptiveDifficulty lets the PVC opponent follow the creator's recent results
+	AdaptiveDifficulty bool `json:"adaptiveDifficulty"`
+	// OpponentID names a registered bot to play against in PVC instead of the built-in AI
+	OpponentID string `json:"opponentId"`
+	// PlayAs is the creator's seat in PVC ("X", "O" or "RANDOM"); the AI opens if the creator takes O
+	PlayAs string `json:"playAs"`
+	// Private hides a PVP game from GET /games; opponents join with the invite code
+	Private bool `json:"private"`
+}
+
+// personalityDTO describes a human-like AI opponent
+type personalityDTO struct {
+	Name             string  `json:"name"`
+	BlunderRate      float64 `json:"blunderRate"`
+	CornerPreference float64 `json:"cornerPreference"`
+	Aggression       float64 `json:"aggression"`
+	ThinkTimeMs      int64   `json:"thinkTimeMs"`
+	ThinkJitterMs    int64   `json:"thinkJitterMs"`
+}
+
+// difficultyDTO reports the adaptive level a game is played at
+type difficultyDTO struct {
+	Level       int `json:"level"`
+	MaxLevel    int `json:"maxLevel"`
+	Streak      int `json:"streak"`
+	GamesPlayed int `json:"gamesPlayed"`
 }
 
 type createGameResponse struct {
 	GameID      string     `json:"gameId"`
 	Mode        string     `json:"mode"`
+	Variant     string     `json:"variant"`
+	Torus       bool       `json:"torus"`
 	Board       [][]string `json:"board"`
 	CurrentTurn string     `json:"currentTurn"`
 	Status      string     `json:"status"`
 	Winner      string     `json:"winner"`
+	// ForfeitedBy is the seat that lost by forfeit (e.g. a bot that timed out)
+	ForfeitedBy string `json:"forfeitedBy,omitempty"`
+	// PlayerXID and PlayerOID identify the seats; computer opponents are bot players
+	PlayerXID string `json:"playerXId"`
+	PlayerOID string `json:"playerOId,omitempty"`
+	// Roles maps seat ("X"/"O") to role in asymmetric variants
+	Roles map[string]string `json:"roles,omitempty"`
+	// HintsDisabled reports whether hints may be requested in this game
+	HintsDisabled bool `json:"hintsDisabled"`
+	// AIStrategy is the PVC opponent's algorithm
+	AIStrategy string `json:"aiStrategy,omitempty"`
+	// AIStrategies maps seat to algorithm in CVC games
+	AIStrategies map[string]string `json:"aiStrategies,omitempty"`
+	// MovePaceMs is the pause before each move in CVC games
+	MovePaceMs int64 `json:"movePaceMs,omitempty"`
+	// Personality of a human-like PVC opponent
+	Personality *personalityDTO `json:"personality,omitempty"`
+	// Difficulty is the creator's adaptive level, if the game adapts to them
+	Difficulty *difficultyDTO `json:"difficulty,omitempty"`
+	// HintsUsed counts hints per seat ("X"/"O")
+	HintsUsed map[string]int `json:"hintsUsed,omitempty"`
+	// Private games are not listed; only their creator sees the invite code
+	Private         bool   `json:"private,omitempty"`
+	InviteCode      string `json:"inviteCode,omitempty"`
+	InviteExpiresAt string `json:"inviteExpiresAt,omitempty"`
+	// TimeControl is the clock agreed on in a challenge
+	TimeControl *timeControlDTO `json:"timeControl,omitempty"`
+	// ClocksMs is each seat's ("X"/"O") thinking time left once the clock runs
+	ClocksMs map[string]int64 `json:"clocksMs,omitempty"`
+}
+
+type joinByCodeRequest struct {
+	Code string `json:"code"`
+}
+
+// timeControlDTO is a clock in whole seconds
+type timeControlDTO struct {
+	InitialSeconds   int64 `json:"initialSeconds"`
+	IncrementSeconds int64 `json:"incrementSeconds"`
+}
+
+func newTimeControlDTO(tc *models.TimeControl) *timeControlDTO {
+	if tc == nil {
+		return nil
+	}
+	return &timeControlDTO{
+		InitialSeconds:   int64(tc.Initial / time.Second),
+		IncrementSeconds: int64(tc.Increment / time.Second),
+	}
+}
+
+type createChallengeRequest struct {
+	TargetID string `json:"targetId"`
+	Mode     string `json:"mode"`
+	Variant  string `json:"variant"`
+	// TimeControl is optional; omit it for games without a clock
+	TimeControl *timeControlDTO `json:"timeControl"`
+}
+
+type challengeDTO struct {
+	ChallengeID  string          `json:"challengeId"`
+	ChallengerID string          `json:"challengerId"`
+	TargetID     string          `json:"targetId"`
+	Mode         string          `json:"mode"`
+	Variant      string          `json:"variant"`
+	TimeControl  *timeControlDTO `json:"timeControl,omitempty"`
+	Status       string          `json:"status"`
+	// GameID is set once the challenge was accepted
+	GameID    string `json:"gameId,omitempty"`
+	CreatedAt string `json:"createdAt"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+func newChallengeDTO(c *models.Challenge) challengeDTO {
+	return challengeDTO{
+		ChallengeID:  c.ID,
+		ChallengerID: c.ChallengerID,
+		TargetID:     c.TargetID,
+		Mode:         string(c.Mode),
+		Variant:      string(c.Variant),
+		TimeControl:  newTimeControlDTO(c.TimeControl),
+		Status:       string(c.Status),
+		GameID:       c.GameID,
+		CreatedAt:    c.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:    c.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+type listChallengesResponse struct {
+	Challenges []challengeDTO `json:"challenges"`
+}
+
+type addFriendRequest struct {
+	FriendID string `json:"friendId"`
+}
+
+type presenceDTO struct {
+	PlayerID string `json:"playerId"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	GameID   string `json:"gameId,omitempty"`
+}
+
+type listFriendsResponse struct {
+	Friends []presenceDTO `json:"friends"`
+}
+
+type notificationDTO struct {
+	NotificationID string `json:"notificationId"`
+	Type           string `json:"type"`
+	GameID         string `json:"gameId,omitempty"`
+	ChallengeID    string `json:"challengeId,omitempty"`
+	Message        string `json:"message"`
+	Read           bool   `json:"read"`
+	CreatedAt      string `json:"createdAt"`
+}
+
+type listNotificationsResponse struct {
+	Notifications []notificationDTO `json:"notifications"`
+	// Unread counts the unread notifications in the list
+	Unread int `json:"unread"`
+}
+
+type markReadRequest struct {
+	// IDs of the notifications to mark; empty marks all
+	IDs []string `json:"ids"`
+}
+
+type acceptChallengeResponse struct {
+	Challenge challengeDTO       `json:"challenge"`
+	Game      createGameResponse `json:"game"`
 }
 
 // GAME SUMMARY DTO
@@ -85,6 +296,171 @@ type listGamesResponse struct {
 type makeMoveRequest struct {
 	Row int `json:"row"`
 	Col int `json:"col"`
+	// Symbol is the mark to place in variants where players choose it ("X" or "O")
+	Symbol string `json:"symbol"`
+	// From is the [row, col] of the piece to slide in THREE_MENS_MORRIS
+	From *[2]int `json:"from"`
+	// Number is the number to place in NUMERICAL (1-9)
+	Number int `json:"number"`
+}
+
+// HINT DTO
+type hintResponse struct {
+	Row    int    `json:"row"`
+	Col    int    `json:"col"`
+	Reason string `json:"reason"`
+}
+
+// REVIEW DTOs
+type moveReviewDTO struct {
+	Ply        int      `json:"ply"`
+	Seat       string   `json:"seat"`
+	Row        int      `json:"row"`
+	Col        int      `json:"col"`
+	Result     string   `json:"result"`
+	BestResult string   `json:"bestResult"`
+	Quality    string   `json:"quality"`
+	BestMoves  [][2]int `json:"bestMoves"`
+}
+
+type reviewResponse struct {
+	GameID   string             `json:"gameId"`
+	Moves    []moveReviewDTO    `json:"moves"`
+	Accuracy map[string]float64 `json:"accuracy"`
+}
+
+// TRAINING DTOs
+type trainRequest struct {
+	Games int `json:"games"`
+}
+
+type trainingStatsResponse struct {
+	GamesTrained int `json:"gamesTrained"`
+	Wins         int `json:"wins"`
+	Draws        int `json:"draws"`
+	Losses       int `json:"losses"`
+	HumanGames   int `json:"humanGames"`
+	Positions    int `json:"positions"`
+}
+
+// newTrainingStatsResponse converts training statistics into their JSON representation.
+func newTrainingStatsResponse(stats *models.TrainingStats) trainingStatsResponse {
+	return trainingStatsResponse{
+		GamesTrained: stats.GamesTrained,
+		Wins:         stats.Wins,
+		Draws:        stats.Draws,
+		Losses:       stats.Losses,
+		HumanGames:   stats.HumanGames,
+		Positions:    stats.Positions,
+	}
+}
+
+// newGameResponse builds the common game representation as seen by the given
+// player. In a running fog-of-war game the board only shows what that player
+// is allowed to know.
+func newGameResponse(gameState *models.GameState, playerID string) createGameResponse {
+	visible := game.BoardForPlayer(gameState, playerID)
+
+	// Convert board [][]Symbol to [][]string for JSON response.
+	// Blocked cells are sent as "#".
+	board := make([][]string, len(visible))
+	for i := range visible {
+		board[i] = make([]string, len(visible[i]))
+		for j := range visible[i] {
+			board[i][j] = string(visible[i][j])
+		}
+	}
+
+	resp := createGameResponse{
+		GameID:      gameState.ID,
+		Mode:        string(gameState.Mode),
+		Variant:     string(gameState.Variant),
+		Torus:       gameState.Torus,
+		Board:       board,
+		CurrentTurn: string(gameState.CurrentTurn),
+		Status:      string(gameState.Status),
+		Winner:      gameState.Winner,
+		ForfeitedBy: string(gameState.ForfeitedBy),
+		PlayerXID:   gameState.PlayerXID,
+		PlayerOID:   gameState.PlayerOID,
+
+		HintsDisabled: gameState.HintsDisabled,
+		Private:       gameState.Private,
+		TimeControl:   newTimeControlDTO(gameState.TimeControl),
+	}
+	if gameState.Clocks != nil {
+		now := time.Now()
+		resp.ClocksMs = make(map[string]int64, len(gameState.Clocks))
+		for seat := range gameState.Clocks {
+			left, _ := game.RemainingTime(gameState, seat, now)
+			resp.ClocksMs[string(seat)] = left.Milliseconds()
+		}
+	}
+	if gameState.Private && playerID == gameState.CreatedBy {
+		resp.InviteCode = gameState.InviteCode
+		resp.InviteExpiresAt = gameState.InviteExpiresAt.Format(time.RFC3339)
+	}
+	switch gameState.Mode {
+	case models.GameModePVC:
+		resp.AIStrategy = string(gameState.AIStrategy)
+	case models.GameModeCVC:
+		resp.AIStrategies = make(map[string]string, len(gameState.AIStrategies))
+		for seat, strategy := range gameState.AIStrategies {
+			resp.AIStrategies[string(seat)] = string(strategy)
+		}
+		resp.MovePaceMs = gameState.MovePace.Milliseconds()
+	}
+	if p := gameState.Personality; p != nil {
+		resp.Personality = &personalityDTO{
+			Name:             p.Name,
+			BlunderRate:      p.BlunderRate,
+			CornerPreference: p.CornerPreference,
+			Aggression:       p.Aggression,
+			ThinkTimeMs:      p.ThinkTime.Milliseconds(),
+			ThinkJitterMs:    p.ThinkJitter.Milliseconds(),
+		}
+	}
+	if d := gameState.Difficulty; d != nil {
+		resp.Difficulty = &difficultyDTO{
+			Level:       d.Level,
+			MaxLevel:    ai.MaxDifficultyLevel(),
+			Streak:      d.Streak,
+			GamesPlayed: d.GamesPlayed,
+		}
+	}
+	if len(gameState.Roles) > 0 {
+		resp.Roles = make(map[string]string, len(gameState.Roles))
+		for seat, role := range gameState.Roles {
+			resp.Roles[string(seat)] = string(role)
+		}
+	}
+	if len(gameState.HintsUsed) > 0 {
+		resp.HintsUsed = make(map[string]int, len(gameState.HintsUsed))
+		for seat, count := range gameState.HintsUsed {
+			resp.HintsUsed[string(seat)] = count
+		}
+	}
+	return resp
+}
+
+// ANALYSIS DTOs
+type analysisRequest struct {
+	Board  [][]string `json:"board"`
+	ToMove string     `json:"toMove"`
+}
+
+type moveAnalysisDTO struct {
+	Row      int    `json:"row"`
+	Col      int    `json:"col"`
+	Result   string `json:"result"`
+	Distance int    `json:"distance"`
+}
+
+type analysisResponse struct {
+	ToMove   string            `json:"toMove"`
+	Result   string            `json:"result"`
+	Distance int               `json:"distance"`
+	Moves    []moveAnalysisDTO `json:"moves"`
 }
 
 // ----------------------
@@ -122,6 +498,65 @@ func CreatePlayerHandler(playerSvc service.PlayerService) http.HandlerFunc {
 	}
 }
 
+// RegisterBotHandler handles POST /bots and registers an external engine.
+// The server posts the position to the callback URL whenever it is the bot's turn.
+func RegisterBotHandler(playerSvc service.PlayerService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req registerBotRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		player, err := playerSvc.RegisterBot(r.Context(), req.Name, req.CallbackURL)
+		if err != nil {
+			if errors.Is(err, service.ErrInvalidBot) {
+				http.Error(w, "name and an http(s) callbackUrl are required", http.StatusBadRequest)
+				return
+			}
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		resp := registerBotResponse{
+			PlayerID:    player.ID,
+			Name:        player.Name,
+			CallbackURL: player.Bot.CallbackURL,
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// ListBotsHandler handles GET /bots and lists the opponents a PVC game can
+// name as opponentId.
+func ListBotsHandler(playerSvc service.PlayerService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bots, err := playerSvc.ListBots(r.Context())
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		resp := listBotsResponse{Bots: make([]botDTO, 0, len(bots))}
+		for _, bot := range bots {
+			resp.Bots = append(resp.Bots, botDTO{
+				PlayerID:    bot.ID,
+				Name:        bot.Name,
+				Rating:      bot.Rating,
+				Strategy:    string(bot.Bot.Strategy),
+				Personality: bot.Bot.Personality,
+				External:    bot.Bot.CallbackURL != "",
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
 // healthHandler serves a minimal health check response so that clients
 // and deployment environments can verify the server is running.
 func healthHandler(w http.ResponseWriter, r *http.Request) {
@@ -154,33 +589,71 @@ func CreateGameHandler(gameSvc service.GameService) http.HandlerFunc {
 		}
 
 		mode := models.GameMode(req.Mode)
-		gameState, err := gameSvc.CreateGame(r.Context(), playerID, mode)
+		opts := service.GameOptions{
+			Variant:            models.GameVariant(req.Variant),
+			Role:               models.Role(req.Role),
+			BlockedCells:       req.BlockedCells,
+			RandomBlockedCells: req.RandomBlockedCells,
+			Torus:              req.Torus,
+			DisableHints:       req.DisableHints,
+			AIStrategy:         models.AIStrategy(req.AIStrategy),
+			OpponentAIStrategy: models.AIStrategy(req.OpponentAIStrategy),
+			MovePace:           time.Duration(req.MovePaceMs) * time.Millisecond,
+			AIIterations:       req.AIIterations,
+			AITimeLimit:        time.Duration(req.AITimeLimitMs) * time.Millisecond,
+			Personality:        req.Personality,
+			AdaptiveDifficulty: req.AdaptiveDifficulty,
+			OpponentID:         req.OpponentID,
+			PlayAs:             req.PlayAs,
+			Private:            req.Private,
+		}
+		gameState, err := gameSvc.CreateGameWithOptions(r.Context(), playerID, mode, opts)
 		if err != nil {
 			if errors.Is(err, service.ErrInvalidGameMode) {
 				http.Error(w, "invalid mode", http.StatusBadRequest)
 				return
 			}
+			if errors.Is(err, service.ErrInvalidVariant) {
+				http.Error(w, "invalid variant", http.StatusBadRequest)
+				return
+			}
+			if errors.Is(err, service.ErrInvalidRole) {
+				http.Error(w, "invalid role", http.StatusBadRequest)
+				return
+			}
+			if errors.Is(err, service.ErrInvalidBoard) {
+				http.Error(w, "invalid blocked cells", http.StatusBadRequest)
+				return
+			}
+			if errors.Is(err, service.ErrInvalidStrategy) {
+				http.Error(w, "invalid AI strategy", http.StatusBadRequest)
+				return
+			}
+			if errors.Is(err, service.ErrInvalidPersonality) {
+				http.Error(w, "invalid AI personality", http.StatusBadRequest)
+				return
+			}
+			if errors.Is(err, service.ErrInvalidDifficulty) {
+				http.Error(w, "invalid adaptive difficulty", http.StatusBadRequest)
+				return
+			}
+			if errors.Is(err, service.ErrInvalidSeat) {
+				http.Error(w, "invalid playAs seat", http.StatusBadRequest)
+				return
+			}
+			if errors.Is(err, service.ErrInvalidPace) {
+				http.Error(w, "invalid move pace", http.StatusBadRequest)
+				return
+			}
+			if errors.Is(err, service.ErrInvalidOpponent) {
+				http.Error(w, "opponent is not an available bot", http.StatusBadRequest)
+				return
+			}
 			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 			return
 		}
 
-		// Convert board [3][3]Symbol to [][]string for JSON response.
-		board := make([][]string, 3)
-		for i := 0; i < 3; i++ {
-			board[i] = make([]string, 3)
-			for j := 0; j < 3; j++ {
-				board[i][j] = string(gameState.Board[i][j])
-			}
-		}
-
-		resp := createGameResponse{
-			GameID:      gameState.ID,
-			Mode:        string(gameState.Mode),
-			Board:       board,
-			CurrentTurn: string(gameState.CurrentTurn),
-			Status:      string(gameState.Status),
-			Winner:      gameState.Winner,
-		}
+		resp := newGameResponse(gameState, playerID)
 
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusCreated)
@@ -261,6 +734,9 @@ func JoinGameHandler(gameSvc service.GameService) http.HandlerFunc {
 			case errors.Is(err, service.ErrInvalidGameState):
 				http.Error(w, "invalid game state", http.StatusBadRequest)
 				return
+			case errors.Is(err, service.ErrPrivateGame):
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
 			case errors.Is(err, store.ErrGameNotFound):
 				http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
 				return
@@ -270,24 +746,52 @@ func JoinGameHandler(gameSvc service.GameService) http.HandlerFunc {
 			}
 		}
 
-		// build response using existing helper-style logic
-		board := make([][]string, 3)
-		for i := 0; i < 3; i++ {
-			board[i] = make([]string, 3)
-			for j := 0; j < 3; j++ {
-				board[i][j] = string(gameState.Board[i][j])
-			}
+		resp := newGameResponse(gameState, playerID)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// JoinGameByCodeHandler handles POST /games/join-by-code, the way into a
+// private game.
+func JoinGameByCodeHandler(gameSvc service.GameService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		playerID := PlayerIDFromContext(r.Context())
+		if playerID == "" {
+			http.Error(w, "missing X-Player-Id header", http.StatusBadRequest)
+			return
+		}
+
+		var req joinByCodeRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
 		}
 
-		resp := createGameResponse{
-			GameID:      gameState.ID,
-			Mode:        string(gameState.Mode),
-			Board:       board,
-			CurrentTurn: string(gameState.CurrentTurn),
-			Status:      string(gameState.Status),
-			Winner:      gameState.Winner,
+		gameState, err := gameSvc.JoinGameByCode(r.Context(), req.Code, playerID)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrInvalidGameState):
+				http.Error(w, "invalid game state", http.StatusBadRequest)
+				return
+			case errors.Is(err, store.ErrPlayerNotFound):
+				http.Error(w, "player not found", http.StatusBadRequest)
+				return
+			case errors.Is(err, service.ErrInvalidInviteCode):
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			case errors.Is(err, service.ErrInviteExpired):
+				http.Error(w, err.Error(), http.StatusGone)
+				return
+			default:
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
 		}
 
+		resp := newGameResponse(gameState, playerID)
+
 		w.Header().Set("Content-Type", "application/json")
 		_ = json.NewEncoder(w).Encode(resp)
 	}
@@ -295,6 +799,9 @@ func JoinGameHandler(gameSvc service.GameService) http.HandlerFunc {
 
 func GetGameHandler(gameSvc service.GameService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		// Optional: identifies whose view of a fog-of-war board to return.
+		playerID := PlayerIDFromContext(r.Context())
+
 		gameID := chi.URLParam(r, "gameId")
 		if gameID == "" {
 			http.Error(w, "missing gameId", http.StatusBadRequest)
@@ -311,22 +818,7 @@ func GetGameHandler(gameSvc service.GameService) http.HandlerFunc {
 			return
 		}
 
-		board := make([][]string, 3)
-		for i := 0; i < 3; i++ {
-			board[i] = make([]string, 3)
-			for j := 0; j < 3; j++ {
-				board[i][j] = string(gameState.Board[i][j])
-			}
-		}
-
-		resp := createGameResponse{
-			GameID:      gameState.ID,
-			Mode:        string(gameState.Mode),
-			Board:       board,
-			CurrentTurn: string(gameState.CurrentTurn),
-			Status:      string(gameState.Status),
-			Winner:      gameState.Winner,
-		}
+		resp := newGameResponse(gameState, playerID)
 
 		w.Header().Set("Content-Type", "application/json")
 		_ = json.NewEncoder(w).Encode(resp)
@@ -353,7 +845,14 @@ func MakeMoveHandler(gameSvc service.GameService) http.HandlerFunc {
 			return
 		}
 
-		gameState, err := gameSvc.MakeMove(r.Context(), gameID, playerID, req.Row, req.Col)
+		move := models.Move{
+			Row:    req.Row,
+			Col:    req.Col,
+			Symbol: models.Symbol(req.Symbol),
+			From:   req.From,
+			Number: req.Number,
+		}
+		gameState, err := gameSvc.PlayMove(r.Context(), gameID, playerID, move)
 		if err != nil {
 			switch {
 			case errors.Is(err, store.ErrGameNotFound):
@@ -362,6 +861,20 @@ func MakeMoveHandler(gameSvc service.GameService) http.HandlerFunc {
 			case errors.Is(err, service.ErrNotParticipant):
 				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
 				return
+			case errors.Is(err, service.ErrHiddenCellTaken):
+				// Reply with the player's updated view so the client can show the revealed cell.
+				gameState, err = gameSvc.GetGame(r.Context(), gameID)
+				if err != nil {
+					http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(newGameResponse(gameState, playerID))
+				return
+			case errors.Is(err, service.ErrTimeExpired):
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
 			case errors.Is(err, service.ErrNotPlayersTurn),
 				errors.Is(err, service.ErrInvalidMove),
 				errors.Is(err, service.ErrInvalidGameState):
@@ -373,22 +886,7 @@ func MakeMoveHandler(gameSvc service.GameService) http.HandlerFunc {
 			}
 		}
 
-		board := make([][]string, 3)
-		for i := 0; i < 3; i++ {
-			board[i] = make([]string, 3)
-			for j := 0; j < 3; j++ {
-				board[i][j] = string(gameState.Board[i][j])
-			}
-		}
-
-		resp := createGameResponse{
-			GameID:      gameState.ID,
-			Mode:        string(gameState.Mode),
-			Board:       board,
-			CurrentTurn: string(gameState.CurrentTurn),
-			Status:      string(gameState.Status),
-			Winner:      gameState.Winner,
-		}
+		resp := newGameResponse(gameState, playerID)
 
 		w.Header().Set("Content-Type", "application/json")
 		_ = json.NewEncoder(w).Encode(resp)
@@ -431,8 +929,15 @@ func WebSocketHandler(hub *ws.Hub, gameSvc service.GameService) http.HandlerFunc
 			return
 		}
 
+		// Browsers cannot set headers on a WebSocket handshake, so the
+		// player may also be identified via ?playerId=...
+		playerID := PlayerIDFromContext(r.Context())
+		if playerID == "" {
+			playerID = r.URL.Query().Get("playerId")
+		}
+
 		// Create connection wrapper
-		wsConn := ws.NewConnection(hub, conn)
+		wsConn := ws.NewPlayerConnection(hub, conn, playerID)
 		hub.Register(gameID, wsConn)
 
 		// Send initial game state immediately
@@ -443,3 +948,653 @@ func WebSocketHandler(hub *ws.Hub, gameSvc service.GameService) http.HandlerFunc
 		go wsConn.ReadPump()
 	}
 }
+
+// PlayerChannelHandler upgrades GET /ws/players/{playerId} to the player's
+// own WebSocket channel, which receives events that are not tied to a game,
+// such as incoming challenges. The caller must identify as that player.
+func PlayerChannelHandler(hub *ws.Hub, playerSvc service.PlayerService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		playerID := chi.URLParam(r, "playerId")
+
+		// Only the player may open their channel. Browsers cannot set headers
+		// on a WebSocket handshake, so ?playerId=... is accepted as well.
+		caller := PlayerIDFromContext(r.Context())
+		if caller == "" {
+			caller = r.URL.Query().Get("playerId")
+		}
+		if caller == "" {
+			http.Error(w, "missing X-Player-Id header", http.StatusBadRequest)
+			return
+		}
+		if caller != playerID {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+
+		if _, err := playerSvc.GetPlayer(r.Context(), playerID); err != nil {
+			if errors.Is(err, store.ErrPlayerNotFound) {
+				http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+				return
+			}
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		upgrader := websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin: func(r *http.Request) bool {
+				// Allow all origins for development
+				return true
+			},
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			log.Printf("websocket upgrade error: %v", err)
+			return
+		}
+
+		wsConn := ws.NewPlayerConnection(hub, conn, playerID)
+		hub.RegisterPlayer(wsConn)
+
+		go wsConn.WritePump()
+		go wsConn.ReadPump()
+	}
+}
+
+// ListFriendsHandler handles GET /players/{playerId}/friends and returns
+// every friend with their presence.
+func ListFriendsHandler(friendSvc service.FriendService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		friends, err := friendSvc.ListFriends(r.Context(), chi.URLParam(r, "playerId"))
+		if err != nil {
+			if errors.Is(err, store.ErrPlayerNotFound) {
+				http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+				return
+			}
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		resp := listFriendsResponse{Friends: make([]presenceDTO, 0, len(friends))}
+		for _, f := range friends {
+			resp.Friends = append(resp.Friends, presenceDTO{
+				PlayerID: f.PlayerID,
+				Name:     f.Name,
+				Status:   string(f.Status),
+				GameID:   f.GameID,
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// AddFriendHandler handles POST /players/{playerId}/friends. Players can
+// only change their own friend list.
+func AddFriendHandler(friendSvc service.FriendService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		playerID, ok := ownPlayerID(w, r)
+		if !ok {
+			return
+		}
+
+		var req addFriendRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		if err := friendSvc.AddFriend(r.Context(), playerID, req.FriendID); err != nil {
+			writeFriendError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// RemoveFriendHandler handles DELETE /players/{playerId}/friends/{friendId}.
+func RemoveFriendHandler(friendSvc service.FriendService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		playerID, ok := ownPlayerID(w, r)
+		if !ok {
+			return
+		}
+
+		if err := friendSvc.RemoveFriend(r.Context(), playerID, chi.URLParam(r, "friendId")); err != nil {
+			writeFriendError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// ownPlayerID returns the {playerId} of the URL if it matches the
+// X-Player-Id header; otherwise it writes the error response.
+func ownPlayerID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	playerID := PlayerIDFromContext(r.Context())
+	if playerID == "" {
+		http.Error(w, "missing X-Player-Id header", http.StatusBadRequest)
+		return "", false
+	}
+	if playerID != chi.URLParam(r, "playerId") {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return "", false
+	}
+	return playerID, true
+}
+
+// writeFriendError maps errors of changing a friend list to HTTP statuses.
+func writeFriendError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, store.ErrPlayerNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	case errors.Is(err, service.ErrInvalidFriend):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
+
+// ListNotificationsHandler handles GET /players/me/notifications and returns
+// the requesting player's inbox, newest first. ?unread=true leaves out read
+// notifications.
+func ListNotificationsHandler(notificationSvc service.NotificationService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		playerID := PlayerIDFromContext(r.Context())
+		if playerID == "" {
+			http.Error(w, "missing X-Player-Id header", http.StatusBadRequest)
+			return
+		}
+
+		unreadOnly := r.URL.Query().Get("unread") == "true"
+		notifications, err := notificationSvc.ListNotifications(r.Context(), playerID, unreadOnly)
+		if err != nil {
+			if errors.Is(err, store.ErrPlayerNotFound) {
+				http.Error(w, "player not found", http.StatusBadRequest)
+				return
+			}
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		resp := listNotificationsResponse{Notifications: make([]notificationDTO, 0, len(notifications))}
+		for _, n := range notifications {
+			if !n.Read {
+				resp.Unread++
+			}
+			resp.Notifications = append(resp.Notifications, notificationDTO{
+				NotificationID: n.ID,
+				Type:           string(n.Type),
+				GameID:         n.GameID,
+				ChallengeID:    n.ChallengeID,
+				Message:        n.Message,
+				Read:           n.Read,
+				CreatedAt:      n.CreatedAt.Format(time.RFC3339),
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// MarkNotificationsReadHandler handles POST /players/me/notifications/read.
+func MarkNotificationsReadHandler(notificationSvc service.NotificationService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		playerID := PlayerIDFromContext(r.Context())
+		if playerID == "" {
+			http.Error(w, "missing X-Player-Id header", http.StatusBadRequest)
+			return
+		}
+
+		var req markReadRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		if err := notificationSvc.MarkRead(r.Context(), playerID, req.IDs); err != nil {
+			if errors.Is(err, store.ErrPlayerNotFound) {
+				http.Error(w, "player not found", http.StatusBadRequest)
+				return
+			}
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// CreateChallengeHandler handles POST /challenges: the requesting player
+// challenges the target to a game.
+func CreateChallengeHandler(challengeSvc service.ChallengeService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		playerID := PlayerIDFromContext(r.Context())
+		if playerID == "" {
+			http.Error(w, "missing X-Player-Id header", http.StatusBadRequest)
+			return
+		}
+
+		var req createChallengeRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		opts := service.ChallengeOptions{
+			Mode:    models.GameMode(req.Mode),
+			Variant: models.GameVariant(req.Variant),
+		}
+		if tc := req.TimeControl; tc != nil {
+			opts.TimeControl = &models.TimeControl{
+				Initial:   time.Duration(tc.InitialSeconds) * time.Second,
+				Increment: time.Duration(tc.IncrementSeconds) * time.Second,
+			}
+		}
+
+		challenge, err := challengeSvc.CreateChallenge(r.Context(), playerID, req.TargetID, opts)
+		if err != nil {
+			switch {
+			case errors.Is(err, store.ErrPlayerNotFound):
+				http.Error(w, "player not found", http.StatusBadRequest)
+				return
+			case errors.Is(err, service.ErrInvalidChallenge),
+				errors.Is(err, service.ErrInvalidGameMode),
+				errors.Is(err, service.ErrInvalidVariant),
+				errors.Is(err, service.ErrInvalidTimeControl):
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			default:
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(newChallengeDTO(challenge))
+	}
+}
+
+// ListChallengesHandler handles GET /challenges and lists the requesting
+// player's pending challenges, sent and received.
+func ListChallengesHandler(challengeSvc service.ChallengeService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		playerID := PlayerIDFromContext(r.Context())
+		if playerID == "" {
+			http.Error(w, "missing X-Player-Id header", http.StatusBadRequest)
+			return
+		}
+
+		challenges, err := challengeSvc.ListChallenges(r.Context(), playerID)
+		if err != nil {
+			if errors.Is(err, store.ErrPlayerNotFound) {
+				http.Error(w, "player not found", http.StatusBadRequest)
+				return
+			}
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		resp := listChallengesResponse{Challenges: make([]challengeDTO, 0, len(challenges))}
+		for _, c := range challenges {
+			resp.Challenges = append(resp.Challenges, newChallengeDTO(c))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// AcceptChallengeHandler handles POST /challenges/{challengeId}/accept and
+// returns the challenge together with the new game.
+func AcceptChallengeHandler(challengeSvc service.ChallengeService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		playerID := PlayerIDFromContext(r.Context())
+		if playerID == "" {
+			http.Error(w, "missing X-Player-Id header", http.StatusBadRequest)
+			return
+		}
+
+		challenge, gameState, err := challengeSvc.AcceptChallenge(r.Context(), chi.URLParam(r, "challengeId"), playerID)
+		if err != nil {
+			writeChallengeError(w, err)
+			return
+		}
+
+		resp := acceptChallengeResponse{
+			Challenge: newChallengeDTO(challenge),
+			Game:      newGameResponse(gameState, playerID),
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// DeclineChallengeHandler handles POST /challenges/{challengeId}/decline.
+func DeclineChallengeHandler(challengeSvc service.ChallengeService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		playerID := PlayerIDFromContext(r.Context())
+		if playerID == "" {
+			http.Error(w, "missing X-Player-Id header", http.StatusBadRequest)
+			return
+		}
+
+		challenge, err := challengeSvc.DeclineChallenge(r.Context(), chi.URLParam(r, "challengeId"), playerID)
+		if err != nil {
+			writeChallengeError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(newChallengeDTO(challenge))
+	}
+}
+
+// writeChallengeError maps errors of answering a challenge to HTTP statuses.
+func writeChallengeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, store.ErrChallengeNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	case errors.Is(err, service.ErrNotChallengeTarget):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, service.ErrChallengeClosed):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, service.ErrChallengeExpired):
+		http.Error(w, err.Error(), http.StatusGone)
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
+
+// HintHandler suggests a move for the requesting player, who must be on turn.
+// Every hint is counted on the game.
+func HintHandler(gameSvc service.GameService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		playerID := PlayerIDFromContext(r.Context())
+		if playerID == "" {
+			http.Error(w, "missing X-Player-Id header", http.StatusBadRequest)
+			return
+		}
+
+		gameID := chi.URLParam(r, "gameId")
+		if gameID == "" {
+			http.Error(w, "missing gameId", http.StatusBadRequest)
+			return
+		}
+
+		hint, err := gameSvc.GetHint(r.Context(), gameID, playerID)
+		if err != nil {
+			switch {
+			case errors.Is(err, store.ErrGameNotFound):
+				http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+				return
+			case errors.Is(err, service.ErrNotParticipant),
+				errors.Is(err, service.ErrHintsDisabled):
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			case errors.Is(err, service.ErrNotPlayersTurn),
+				errors.Is(err, service.ErrInvalidGameState),
+				errors.Is(err, service.ErrHintsUnavailable):
+				http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+				return
+			default:
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		resp := hintResponse{
+			Row:    hint.Row,
+			Col:    hint.Col,
+			Reason: string(hint.Reason),
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// maxRecordSize limits the size of imported game records.
+const maxRecordSize = 64 << 10
+
+// ExportGameHandler returns a game as plain text. The format query parameter
+// selects the game record ("record", the default) or the compact position
+// string of the current board ("position").
+func ExportGameHandler(gameSvc service.GameService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		gameID := chi.URLParam(r, "gameId")
+		if gameID == "" {
+			http.Error(w, "missing gameId", http.StatusBadRequest)
+			return
+		}
+
+		format := r.URL.Query().Get("format")
+		if format != "" && format != "record" && format != "position" {
+			http.Error(w, "unknown format", http.StatusBadRequest)
+			return
+		}
+
+		record, err := gameSvc.ExportGame(r.Context(), gameID)
+		if err != nil {
+			switch {
+			case errors.Is(err, store.ErrGameNotFound):
+				http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+				return
+			case errors.Is(err, service.ErrInvalidGameState):
+				http.Error(w, "game cannot be exported while it is running", http.StatusConflict)
+				return
+			default:
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if format == "position" {
+			gameState, err := gameSvc.GetGame(r.Context(), gameID)
+			if err != nil {
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+			toMove := gameState.CurrentTurn
+			if gameState.Status == models.GameStatusFinished {
+				toMove = models.SymbolEmpty
+			}
+			_, _ = io.WriteString(w, game.FormatPosition(gameState.Board, toMove)+"\n")
+			return
+		}
+		_, _ = io.WriteString(w, game.FormatRecord(record))
+	}
+}
+
+// ImportGameHandler creates a new game from a game record sent as the plain
+// text request body. The requesting player takes seat X.
+func ImportGameHandler(gameSvc service.GameService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		playerID := PlayerIDFromContext(r.Context())
+		if playerID == "" {
+			http.Error(w, "missing X-Player-Id header", http.StatusBadRequest)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRecordSize))
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		record, err := game.ParseRecord(string(body))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		gameState, err := gameSvc.ImportGame(r.Context(), playerID, record)
+		if err != nil {
+			switch {
+			case errors.Is(err, store.ErrPlayerNotFound):
+				http.Error(w, "player not found", http.StatusBadRequest)
+				return
+			case errors.Is(err, service.ErrInvalidGameMode),
+				errors.Is(err, service.ErrInvalidVariant),
+				errors.Is(err, service.ErrInvalidRole),
+				errors.Is(err, service.ErrInvalidBoard),
+				errors.Is(err, service.ErrInvalidRecord):
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			default:
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(newGameResponse(gameState, playerID))
+	}
+}
+
+// ReviewHandler classifies every move of a finished classic game as best,
+// inaccuracy or blunder and reports an accuracy score per player.
+func ReviewHandler(analysisSvc service.AnalysisService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		gameID := chi.URLParam(r, "gameId")
+		if gameID == "" {
+			http.Error(w, "missing gameId", http.StatusBadRequest)
+			return
+		}
+
+		review, err := analysisSvc.ReviewGame(r.Context(), gameID)
+		if err != nil {
+			switch {
+			case errors.Is(err, store.ErrGameNotFound):
+				http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+				return
+			case errors.Is(err, service.ErrInvalidGameState):
+				http.Error(w, "game is not finished", http.StatusConflict)
+				return
+			case errors.Is(err, service.ErrReviewUnavailable):
+				http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+				return
+			default:
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		resp := reviewResponse{
+			GameID:   review.GameID,
+			Moves:    make([]moveReviewDTO, 0, len(review.Moves)),
+			Accuracy: make(map[string]float64, len(review.Accuracy)),
+		}
+		for _, m := range review.Moves {
+			resp.Moves = append(resp.Moves, moveReviewDTO{
+				Ply:        m.Ply,
+				Seat:       string(m.Seat),
+				Row:        m.Row,
+				Col:        m.Col,
+				Result:     string(m.Result),
+				BestResult: string(m.BestResult),
+				Quality:    string(m.Quality),
+				BestMoves:  m.BestMoves,
+			})
+		}
+		for seat, accuracy := range review.Accuracy {
+			resp.Accuracy[string(seat)] = accuracy
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// TrainMenaceHandler trains the MENACE model with a number of self-play games
+// and returns the training progress afterwards.
+func TrainMenaceHandler(learningSvc service.LearningService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req trainRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		stats, err := learningSvc.TrainMenace(r.Context(), req.Games)
+		if err != nil {
+			if errors.Is(err, service.ErrInvalidTraining) {
+				http.Error(w, "invalid number of games", http.StatusBadRequest)
+				return
+			}
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(newTrainingStatsResponse(stats))
+	}
+}
+
+// MenaceStatsHandler returns the training progress of the MENACE model.
+func MenaceStatsHandler(learningSvc service.LearningService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		stats, err := learningSvc.MenaceStats(r.Context())
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(newTrainingStatsResponse(stats))
+	}
+}
+
+// AnalysisHandler evaluates an arbitrary classic position and scores every legal
+// move for the side to move as win, draw or loss under perfect play.
+func AnalysisHandler(analysisSvc service.AnalysisService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req analysisRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		board := make(models.Board, len(req.Board))
+		for i := range req.Board {
+			board[i] = make([]models.Symbol, len(req.Board[i]))
+			for j := range req.Board[i] {
+				board[i][j] = models.Symbol(req.Board[i][j])
+			}
+		}
+
+		analysis, err := analysisSvc.AnalyzePosition(r.Context(), board, models.Symbol(req.ToMove))
+		if err != nil {
+			if errors.Is(err, service.ErrInvalidPosition) {
+				http.Error(w, "invalid or unreachable position", http.StatusBadRequest)
+				return
+			}
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		resp := analysisResponse{
+			ToMove:   string(analysis.ToMove),
+			Result:   string(analysis.Result),
+			Distance: analysis.Distance,
+			Moves:    make([]moveAnalysisDTO, 0, len(analysis.Moves)),
+		}
+		for _, m := range analysis.Moves {
+			resp.Moves = append(resp.Moves, moveAnalysisDTO{
+				Row:      m.Row,
+				Col:      m.Col,
+				Result:   string(m.Result),
+				Distance: m.Distance,
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
@@ -19,6 +19,8 @@
 package http
 
 import (
+	"context"
+	"log"
 	"net/http"
 	"time"
 
@@ -26,6 +28,8 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
 
+	"tic-tac-go/internal/ai"
+	"tic-tac-go/internal/bot"
 	"tic-tac-go/internal/service"
 	"tic-tac-go/internal/store"
 	"tic-tac-go/internal/ws"
@@ -35,11 +39,31 @@ import (
 // For now it only exposes a simple health endpoint; additional routes
 // for game and player APIs will be added later.
 func NewRouter() http.Handler {
+	return NewRouterWithConfig(service.GameServiceConfig{
+		AsyncAI:     true,
+		AIMoveDelay: service.DefaultAIMoveDelay,
+		BotClient:   bot.NewClient(),
+	})
+}
+
+// NewRouterWithConfig constructs the router with the given game service
+// settings. Without a MENACE model a fresh in-memory one is used.
+func NewRouterWithConfig(cfg service.GameServiceConfig) http.Handler {
+	if cfg.Menace == nil {
+		cfg.Menace = ai.NewMenace()
+	}
+
 	r := chi.NewRouter()
 
 	// In-memory stores for players and games.
 	playerStore := store.NewMemoryPlayerStore()
 	gameStore := store.NewMemoryGameStore()
+	challengeStore := store.NewMemoryChallengeStore()
+	notificationStore := store.NewMemoryNotificationStore()
+	// Built-in bots are the PVC and CVC opponents.
+	if err := service.EnsureBuiltInBots(playerStore); err != nil {
+		log.Printf("creating built-in bots failed: %v", err)
+	}
 
 	// WebSocket hub for real-time game updates
 	hub := ws.NewHub()
@@ -47,8 +71,25 @@ func NewRouter() http.Handler {
 
 	// Services using the stores.
 	playerSvc := service.NewPlayerService(playerStore)
+	// Turns, results and challenges land in the players' inboxes and are
+	// streamed over their WebSocket channels.
+	notificationSvc := service.NewNotificationService(notificationStore, playerStore, hub)
+	cfg.Notifier = notificationSvc
+	// Presence is derived from the hub's connections; friends are told
+	// whenever a player connects or disconnects, or starts or finishes a game.
+	friendSvc := service.NewFriendService(playerStore, gameStore, hub, hub)
+	hub.SetPresenceHandler(func(playerID string) {
+		friendSvc.PresenceChanged(context.Background(), playerID)
+	})
+	cfg.Presence = friendSvc
 	// GameService with WebSocket broadcaster
-	gameSvc := service.NewGameServiceWithBroadcaster(gameStore, playerStore, hub)
+	gameSvc := service.NewGameServiceWithConfig(gameStore, playerStore, hub, cfg)
+	// Challenges start their games through the GameService and reach the
+	// challenged player over their WebSocket channel.
+	challengeSvc := service.NewChallengeServiceWithNotifier(challengeStore, playerStore, gameSvc, hub, notificationSvc, service.DefaultChallengeTTL)
+	learningSvc := service.NewLearningService(cfg.Menace)
+	// Perfect-play solver; the full game tree is enumerated once at startup.
+	analysisSvc := service.NewAnalysisService(ai.NewSolver(), gameStore)
 
 	// CORS configuration
 	r.Use(cors.Handler(cors.Options{
@@ -78,15 +119,50 @@ func NewRouter() http.Handler {
 
 	// Player endpoints.
 	r.Post("/players", CreatePlayerHandler(playerSvc))
+	// register an external engine that plays through its callback URL
+	r.Post("/bots", RegisterBotHandler(playerSvc))
+	// list built-in and registered bots
+	r.Get("/bots", ListBotsHandler(playerSvc))
+	// notification inbox of the requesting player
+	r.Get("/players/me/notifications", ListNotificationsHandler(notificationSvc))
+	r.Post("/players/me/notifications/read", MarkNotificationsReadHandler(notificationSvc))
+	// friends and their presence
+	r.Get("/players/{playerId}/friends", ListFriendsHandler(friendSvc))
+	r.Post("/players/{playerId}/friends", AddFriendHandler(friendSvc))
+	r.Delete("/players/{playerId}/friends/{friendId}", RemoveFriendHandler(friendSvc))
 	// Game endpoints.
 	r.Post("/games", CreateGameHandler(gameSvc))
 	// join existing game by id
 	r.Post("/games/{gameId}/join", JoinGameHandler(gameSvc))
+	// join a private game with its invite code
+	r.Post("/games/join-by-code", JoinGameByCodeHandler(gameSvc))
 	// make move within existing game
 	r.Post("/games/{gameId}/moves", MakeMoveHandler(gameSvc))
+	// suggest a move for the player on turn
+	r.Get("/games/{gameId}/hint", HintHandler(gameSvc))
+	// export a game as text; import a game record as a new game
+	r.Get("/games/{gameId}/export", ExportGameHandler(gameSvc))
+	r.Post("/games/import", ImportGameHandler(gameSvc))
+	// move-by-move review of a finished game
+	r.Get("/games/{gameId}/review", ReviewHandler(analysisSvc))
+
+	// Challenges between players.
+	r.Post("/challenges", CreateChallengeHandler(challengeSvc))
+	r.Get("/challenges", ListChallengesHandler(challengeSvc))
+	r.Post("/challenges/{challengeId}/accept", AcceptChallengeHandler(challengeSvc))
+	r.Post("/challenges/{challengeId}/decline", DeclineChallengeHandler(challengeSvc))
+
+	// Self-learning AI: training and progress.
+	r.Post("/ai/menace/train", TrainMenaceHandler(learningSvc))
+	r.Get("/ai/menace", MenaceStatsHandler(learningSvc))
+
+	// Position analysis endpoint.
+	r.Post("/analysis", AnalysisHandler(analysisSvc))
 
 	// WebSocket endpoint for real-time game updates
 	r.Get("/ws/games/{gameId}", WebSocketHandler(hub, gameSvc))
+	// WebSocket channel per player: challenges, friends' presence and notifications
+	r.Get("/ws/players/{playerId}", PlayerChannelHandler(hub, playerSvc))
 
 	return r
 }
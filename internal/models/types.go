@@ -18,15 +18,38 @@
 
 package models
 
-import "time"
+import (
+	"strconv"
+	"time"
+)
 
-// Board is a fixed 3x3 tic-tac-toe game board
-type Board [3][3]Symbol
+// Board is a square tic-tac-toe game board indexed as board[row][col].
+// Classic games use 3x3; some variants play on larger boards.
+type Board [][]Symbol
 
 // Player represents a player in the game
 type Player struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
+	// Difficulty tracks the player's level against the adaptive PVC opponent
+	Difficulty *Difficulty `json:"difficulty,omitempty"`
+	// Bot is set for computer players: the server's built-in bots and
+	// external engines that play through the bot API
+	Bot *BotAccount `json:"bot,omitempty"`
+	// Rating estimates the player's strength
+	Rating int `json:"rating,omitempty"`
+	// Friends holds the IDs of the player's friends; friendship is mutual
+	Friends []string `json:"friends,omitempty"`
+}
+
+// BotAccount describes how a computer player chooses its moves
+type BotAccount struct {
+	// CallbackURL receives the position whenever it is an external bot's
+	// turn and answers with the bot's move; empty for built-in bots
+	CallbackURL string `json:"callbackUrl,omitempty"`
+	// Strategy and Personality (a preset name) are how a built-in bot plays
+	Strategy    AIStrategy `json:"strategy,omitempty"`
+	Personality string     `json:"personality,omitempty"`
 }
 
 // GameMode describes whether a game is player-vs-player or player-vs-computer
@@ -35,6 +58,35 @@ type GameMode string
 const (
 	GameModePVP GameMode = "PVP"
 	GameModePVC GameMode = "PVC"
+	// GameModeCVC lets two AI strategies play each other
+	GameModeCVC GameMode = "CVC"
+)
+
+// GameVariant selects the rule set a game is played with
+type GameVariant string
+
+const (
+	GameVariantClassic  GameVariant = "CLASSIC"
+	GameVariantFogOfWar GameVariant = "FOG_OF_WAR"
+	// GameVariantOrderAndChaos is played on a 6x6 board where both players may place
+	// X or O; ORDER wins with five in a row, CHAOS wins if the board fills without one.
+	GameVariantOrderAndChaos GameVariant = "ORDER_AND_CHAOS"
+	// GameVariantThreeMensMorris gives each player three pieces; once they are all
+	// placed, a turn slides one of your own pieces to an adjacent empty cell.
+	GameVariantThreeMensMorris GameVariant = "THREE_MENS_MORRIS"
+	// GameVariantWild lets either player place X or O; whoever completes a line wins.
+	GameVariantWild GameVariant = "WILD"
+	// GameVariantNumerical has the first player place odd and the second player even
+	// numbers 1-9, each at most once; completing a line that sums to 15 wins.
+	GameVariantNumerical GameVariant = "NUMERICAL"
+)
+
+// Role is the side a player takes in an asymmetric variant such as Order and Chaos
+type Role string
+
+const (
+	RoleOrder Role = "ORDER"
+	RoleChaos Role = "CHAOS"
 )
 
 // GameStatus represents the lifecycle state of a game
@@ -46,28 +98,256 @@ const (
 	GameStatusFinished         GameStatus = "FINISHED"
 )
 
-// Symbol represents the mark used by players on the board
+// Symbol represents the content of a board cell: the mark used by players ("X", "O")
+// or empty. In the numerical variant a cell holds one of the numbers "1" to "9".
+// Seats and turns are always expressed with SymbolX and SymbolO.
 type Symbol string
 
 const (
 	SymbolEmpty Symbol = ""
 	SymbolX     Symbol = "X"
 	SymbolO     Symbol = "O"
+	// SymbolBlocked marks a cell that is permanently unavailable
+	SymbolBlocked Symbol = "#"
 )
 
+// NumberSymbol returns the cell content for the number n in the numerical variant
+func NumberSymbol(n int) Symbol {
+	return Symbol(strconv.Itoa(n))
+}
+
+// Number returns the number held by a cell in the numerical variant.
+// The second result is false if the symbol is not a number.
+func (s Symbol) Number() (int, bool) {
+	n, err := strconv.Atoi(string(s))
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// Move is a single move submitted by a player
+type Move struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+	// Symbol is the mark to place in variants where players choose it
+	// (e.g. Order and Chaos, wild); empty means the player's own seat symbol
+	Symbol Symbol `json:"symbol,omitempty"`
+	// Number is the number to place in the numerical variant (1-9)
+	Number int `json:"number,omitempty"`
+	// From is the [row, col] of the piece to move in sliding variants
+	// (e.g. Three Men's Morris); nil for a placement
+	From *[2]int `json:"from,omitempty"`
+}
+
+// AIStrategy is the algorithm the computer opponent uses to pick its moves
+type AIStrategy string
+
+const (
+	// AIStrategyHeuristic is the default rule-based opponent of each variant
+	AIStrategyHeuristic AIStrategy = "HEURISTIC"
+	// AIStrategyMCTS searches with Monte Carlo Tree Search
+	AIStrategyMCTS AIStrategy = "MCTS"
+	// AIStrategyMenace plays with the self-learning MENACE model (classic games only)
+	AIStrategyMenace AIStrategy = "MENACE"
+	// AIStrategyExternal is an external engine playing through the bot API;
+	// it is set by choosing a bot as opponent
+	AIStrategyExternal AIStrategy = "EXTERNAL"
+)
+
+// Personality describes how a human-like computer opponent plays
+type Personality struct {
+	// Name is the preset the personality was created from
+	Name string `json:"name"`
+	// BlunderRate is the probability (0..1) of overlooking wins and blocks on a move
+	BlunderRate float64 `json:"blunderRate"`
+	// CornerPreference ranges from -1 (prefers edges) to 1 (prefers corners)
+	CornerPreference float64 `json:"cornerPreference"`
+	// Aggression is the probability (0..1) of going for a fork or a new threat
+	// instead of a quiet positional move
+	Aggression float64 `json:"aggression"`
+	// ThinkTime is the average delay before a move; the actual delay varies
+	// by up to ThinkJitter in either direction
+	ThinkTime   time.Duration `json:"thinkTime"`
+	ThinkJitter time.Duration `json:"thinkJitter"`
+}
+
+// Difficulty is the strength of the adaptive computer opponent for one player
+type Difficulty struct {
+	// Level ranges from 1 (weakest) to the strongest level the AI offers
+	Level int `json:"level"`
+	// Streak counts consecutive human wins (positive) or losses (negative)
+	// since the level last changed
+	Streak int `json:"streak"`
+	// GamesPlayed counts finished adaptive games
+	GamesPlayed int `json:"gamesPlayed"`
+}
+
+// TrainingStats reports the training progress of a self-learning AI
+type TrainingStats struct {
+	// GamesTrained counts self-play training games and their results
+	GamesTrained int `json:"gamesTrained"`
+	Wins         int `json:"wins"`
+	Draws        int `json:"draws"`
+	Losses       int `json:"losses"`
+	// HumanGames counts games learned from human opponents
+	HumanGames int `json:"humanGames"`
+	// Positions is the number of distinct positions the model knows
+	Positions int `json:"positions"`
+}
+
+// MoveRecord is a move as it was played, together with the seat that played it
+type MoveRecord struct {
+	Seat Symbol `json:"seat"`
+	Move
+}
+
 // GameState holds the full state of a single tic-tac-toe game
 type GameState struct {
-	ID          string     `json:"id"`
-	Mode        GameMode   `json:"mode"`
-	Board       Board      `json:"board"`
-	PlayerXID   string     `json:"playerXId"`
-	PlayerOID   string     `json:"playerOId"`
-	CurrentTurn Symbol     `json:"currentTurn"`
-	Status      GameStatus `json:"status"`
-	// Winner can be "X", "O", "DRAW", or "" (no winner yet)
-	Winner    string    `json:"winner"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID          string      `json:"id"`
+	Mode        GameMode    `json:"mode"`
+	Variant     GameVariant `json:"variant"`
+	Board       Board       `json:"board"`
+	PlayerXID   string      `json:"playerXId"`
+	PlayerOID   string      `json:"playerOId"`
+	CurrentTurn Symbol      `json:"currentTurn"`
+	Status      GameStatus  `json:"status"`
+	// Winner can be "X", "O", "DRAW", or "" (no winner yet).
+	// In asymmetric variants it names the seat of the winning role.
+	Winner string `json:"winner"`
+	// Roles maps each seat to its role in asymmetric variants (e.g. Order and Chaos).
+	// Seats still decide turn order: X always moves first.
+	Roles map[Symbol]Role `json:"roles,omitempty"`
+	// Torus makes rows, columns and diagonals wrap around the edges of the board
+	Torus bool `json:"torus"`
+	// PositionHistory holds a position key after every move, used for repetition
+	// and move-limit draws in Three Men's Morris
+	PositionHistory []string `json:"positionHistory,omitempty"`
+	// Revealed lists, per symbol, the opponent cells that player has discovered
+	// by trying to move into them (fog-of-war variant only)
+	Revealed map[Symbol][][2]int `json:"revealed,omitempty"`
+	// HintsDisabled is set for games where players may not ask for hints (e.g. rated games)
+	HintsDisabled bool `json:"hintsDisabled"`
+	// HintsUsed counts, per symbol, the hints that player has requested
+	HintsUsed map[Symbol]int `json:"hintsUsed,omitempty"`
+	// History lists every move played so far, in order
+	History []MoveRecord `json:"history,omitempty"`
+	// AIStrategy selects the algorithm of the computer opponent in PVC games
+	AIStrategy AIStrategy `json:"aiStrategy,omitempty"`
+	// AIIterations and AITimeLimit bound the search of the MCTS strategy;
+	// zero means the default budget
+	AIIterations int           `json:"aiIterations,omitempty"`
+	AITimeLimit  time.Duration `json:"aiTimeLimit,omitempty"`
+	// Personality makes the heuristic computer opponent play like a human; nil plays at full strength
+	Personality *Personality `json:"personality,omitempty"`
+	// AIStrategies holds the strategy of each seat in CVC games
+	AIStrategies map[Symbol]AIStrategy `json:"aiStrategies,omitempty"`
+	// MovePace is the pause before each move in CVC games
+	MovePace time.Duration `json:"movePace,omitempty"`
+	// ForfeitedBy is the seat that lost by forfeit, e.g. a bot that did not
+	// answer in time
+	ForfeitedBy Symbol `json:"forfeitedBy,omitempty"`
+	// CreatedBy is the player who created the game
+	CreatedBy string `json:"createdBy,omitempty"`
+	// Private games are hidden from listings and joined with InviteCode,
+	// which is valid until InviteExpiresAt
+	Private         bool      `json:"private,omitempty"`
+	InviteCode      string    `json:"inviteCode,omitempty"`
+	InviteExpiresAt time.Time `json:"inviteExpiresAt,omitempty"`
+	// TimeControl is the clock the players agreed on in a challenge; nil
+	// for games without one
+	TimeControl *TimeControl `json:"timeControl,omitempty"`
+	// Clocks holds each seat's thinking time left once a timed game has
+	// started; the clock of the seat to move runs since TurnStartedAt
+	Clocks        map[Symbol]time.Duration `json:"clocks,omitempty"`
+	TurnStartedAt time.Time                `json:"turnStartedAt,omitempty"`
+	// Difficulty is the player's adaptive level the game is played at; nil if
+	// the game does not adapt to the player
+	Difficulty *Difficulty `json:"difficulty,omitempty"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	UpdatedAt  time.Time   `json:"updatedAt"`
+}
+
+// HintReason is a short explanation of why a suggested move is good
+type HintReason string
+
+const (
+	HintReasonWinNow         HintReason = "win now"
+	HintReasonBlock          HintReason = "block"
+	HintReasonFork           HintReason = "fork"
+	HintReasonBlockFork      HintReason = "block fork"
+	HintReasonTakeCenter     HintReason = "take center"
+	HintReasonOppositeCorner HintReason = "take opposite corner"
+	HintReasonTakeCorner     HintReason = "take corner"
+	HintReasonTakeSide       HintReason = "take side"
+)
+
+// Hint is a suggested move for a human player
+type Hint struct {
+	Row    int        `json:"row"`
+	Col    int        `json:"col"`
+	Reason HintReason `json:"reason"`
+}
+
+// MoveResult is the game-theoretic value of a position or move for the player making it
+type MoveResult string
+
+const (
+	MoveResultWin  MoveResult = "WIN"
+	MoveResultDraw MoveResult = "DRAW"
+	MoveResultLoss MoveResult = "LOSS"
+)
+
+// MoveAnalysis scores a single legal move under perfect play by both sides
+type MoveAnalysis struct {
+	Row    int        `json:"row"`
+	Col    int        `json:"col"`
+	Result MoveResult `json:"result"`
+	// Distance is the number of moves, including this one, until the game ends
+	Distance int `json:"distance"`
+}
+
+// PositionAnalysis is the perfect-play evaluation of a position for the side to move
+type PositionAnalysis struct {
+	ToMove   Symbol         `json:"toMove"`
+	Result   MoveResult     `json:"result"`
+	Distance int            `json:"distance"`
+	Moves    []MoveAnalysis `json:"moves"`
+}
+
+// MoveQuality classifies a played move against perfect play
+type MoveQuality string
+
+const (
+	// MoveQualityBest keeps the best result available in the position
+	MoveQualityBest MoveQuality = "BEST"
+	// MoveQualityInaccuracy gives away a win but still holds the draw
+	MoveQualityInaccuracy MoveQuality = "INACCURACY"
+	// MoveQualityBlunder turns a won or drawn position into a lost one
+	MoveQualityBlunder MoveQuality = "BLUNDER"
+)
+
+// MoveReview is the verdict on a single played move
+type MoveReview struct {
+	Ply  int    `json:"ply"`
+	Seat Symbol `json:"seat"`
+	Row  int    `json:"row"`
+	Col  int    `json:"col"`
+	// Result is the value of the played move for the mover; BestResult the value
+	// of the best move in the position
+	Result     MoveResult  `json:"result"`
+	BestResult MoveResult  `json:"bestResult"`
+	Quality    MoveQuality `json:"quality"`
+	// BestMoves lists the [row, col] cells that achieve BestResult
+	BestMoves [][2]int `json:"bestMoves"`
+}
+
+// GameReview is the move-by-move review of a finished game
+type GameReview struct {
+	GameID string       `json:"gameId"`
+	Moves  []MoveReview `json:"moves"`
+	// Accuracy is the percentage of best moves per seat
+	Accuracy map[Symbol]float64 `json:"accuracy"`
 }
 
 // GameSummary is a lightweight representation used when listing games (e.g., in the lobby)
@@ -79,3 +359,75 @@ type GameSummary struct {
 	CreatedByPlayerID   string     `json:"createdByPlayerId"`
 	CreatedByPlayerName string     `json:"createdByPlayerName"`
 }
+
+// TimeControl is a chess-style clock: every player starts with Initial
+// thinking time and gains Increment after each of their moves
+type TimeControl struct {
+	Initial   time.Duration `json:"initial"`
+	Increment time.Duration `json:"increment"`
+}
+
+// ChallengeStatus represents the lifecycle of a challenge
+type ChallengeStatus string
+
+const (
+	ChallengeStatusPending  ChallengeStatus = "PENDING"
+	ChallengeStatusAccepted ChallengeStatus = "ACCEPTED"
+	ChallengeStatusDeclined ChallengeStatus = "DECLINED"
+	ChallengeStatusExpired  ChallengeStatus = "EXPIRED"
+)
+
+// Challenge is an invitation from one player to another to play a game
+type Challenge struct {
+	ID           string          `json:"id"`
+	ChallengerID string          `json:"challengerId"`
+	TargetID     string          `json:"targetId"`
+	Mode         GameMode        `json:"mode"`
+	Variant      GameVariant     `json:"variant"`
+	TimeControl  *TimeControl    `json:"timeControl,omitempty"`
+	Status       ChallengeStatus `json:"status"`
+	// GameID is the game created when the challenge was accepted
+	GameID    string    `json:"gameId,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// PresenceStatus tells whether a player is connected and playing
+type PresenceStatus string
+
+const (
+	PresenceOffline PresenceStatus = "OFFLINE"
+	PresenceOnline  PresenceStatus = "ONLINE"
+	PresenceInGame  PresenceStatus = "IN_GAME"
+)
+
+// Presence is a player's current online status
+type Presence struct {
+	PlayerID string         `json:"playerId"`
+	Name     string         `json:"name"`
+	Status   PresenceStatus `json:"status"`
+	// GameID is the running game the player is connected to, if IN_GAME
+	GameID string `json:"gameId,omitempty"`
+}
+
+// NotificationType names the event a notification reports
+type NotificationType string
+
+const (
+	NotificationYourTurn     NotificationType = "YOUR_TURN"
+	NotificationChallenged   NotificationType = "CHALLENGED"
+	NotificationGameFinished NotificationType = "GAME_FINISHED"
+)
+
+// Notification is an entry in a player's inbox
+type Notification struct {
+	ID       string           `json:"id"`
+	PlayerID string           `json:"playerId"`
+	Type     NotificationType `json:"type"`
+	// GameID or ChallengeID point at what the notification is about
+	GameID      string    `json:"gameId,omitempty"`
+	ChallengeID string    `json:"challengeId,omitempty"`
+	Message     string    `json:"message"`
+	Read        bool      `json:"read"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
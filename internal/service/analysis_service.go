@@ -0,0 +1,82 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"tic-tac-go/internal/ai"
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+	"tic-tac-go/internal/store"
+)
+
+// analysisService is a concrete implementation of AnalysisService backed by the
+// perfect-play solver.
+type analysisService struct {
+	solver    *ai.Solver
+	gameStore store.GameStore
+}
+
+// NewAnalysisService constructs an AnalysisService using the given solver.
+// Finished games to review are loaded from gameStore.
+func NewAnalysisService(solver *ai.Solver, gameStore store.GameStore) AnalysisService {
+	return &analysisService{
+		solver:    solver,
+		gameStore: gameStore,
+	}
+}
+
+func (s *analysisService) AnalyzePosition(ctx context.Context, board models.Board, toMove models.Symbol) (*models.PositionAnalysis, error) {
+	analysis, err := s.solver.Analyze(board, toMove)
+	if err != nil {
+		if errors.Is(err, ai.ErrUnreachablePosition) {
+			return nil, ErrInvalidPosition
+		}
+		return nil, err
+	}
+	return analysis, nil
+}
+
+// ReviewGame classifies every move of a finished classic game against perfect play.
+func (s *analysisService) ReviewGame(ctx context.Context, gameID string) (*models.GameReview, error) {
+	gameState, err := s.gameStore.Get(gameID)
+	if err != nil {
+		return nil, err
+	}
+	if gameState.Status != models.GameStatusFinished {
+		return nil, ErrInvalidGameState
+	}
+	// The solver only knows the plain 3x3 game.
+	if gameState.Variant != models.GameVariantClassic || gameState.Torus ||
+		game.CountSymbol(gameState.Board, models.SymbolBlocked) > 0 {
+		return nil, ErrReviewUnavailable
+	}
+
+	review, err := s.solver.Review(gameState.History)
+	if err != nil {
+		if errors.Is(err, ai.ErrUnreachablePosition) {
+			return nil, ErrReviewUnavailable
+		}
+		return nil, err
+	}
+	review.GameID = gameState.ID
+	return review, nil
+}
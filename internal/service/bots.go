@@ -0,0 +1,82 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package service
+
+import (
+	"tic-tac-go/internal/models"
+	"tic-tac-go/internal/store"
+)
+
+// builtInBots are the server-managed opponents, one per strategy and
+// personality preset. Their ratings are nominal starting values.
+var builtInBots = []models.Player{
+	{ID: "bot-heuristic", Name: "Heuristic Bot", Rating: 1500,
+		Bot: &models.BotAccount{Strategy: models.AIStrategyHeuristic}},
+	{ID: "bot-mcts", Name: "MCTS Bot", Rating: 1600,
+		Bot: &models.BotAccount{Strategy: models.AIStrategyMCTS}},
+	{ID: "bot-menace", Name: "MENACE Bot", Rating: 1100,
+		Bot: &models.BotAccount{Strategy: models.AIStrategyMenace}},
+	{ID: "bot-novice", Name: "Novice Bot", Rating: 800,
+		Bot: &models.BotAccount{Strategy: models.AIStrategyHeuristic, Personality: "NOVICE"}},
+	{ID: "bot-casual", Name: "Casual Bot", Rating: 1000,
+		Bot: &models.BotAccount{Strategy: models.AIStrategyHeuristic, Personality: "CASUAL"}},
+	{ID: "bot-aggressive", Name: "Aggressive Bot", Rating: 1250,
+		Bot: &models.BotAccount{Strategy: models.AIStrategyHeuristic, Personality: "AGGRESSIVE"}},
+	{ID: "bot-defensive", Name: "Defensive Bot", Rating: 1250,
+		Bot: &models.BotAccount{Strategy: models.AIStrategyHeuristic, Personality: "DEFENSIVE"}},
+	{ID: "bot-machine", Name: "Machine Bot", Rating: 1450,
+		Bot: &models.BotAccount{Strategy: models.AIStrategyHeuristic, Personality: "MACHINE"}},
+	// Mirror bots take seat O when two bots of the same strategy play each
+	// other in CVC, so that every seat is held by its own player.
+	{ID: "bot-heuristic-mirror", Name: "Heuristic Mirror Bot", Rating: 1500,
+		Bot: &models.BotAccount{Strategy: models.AIStrategyHeuristic}},
+	{ID: "bot-mcts-mirror", Name: "MCTS Mirror Bot", Rating: 1600,
+		Bot: &models.BotAccount{Strategy: models.AIStrategyMCTS}},
+	{ID: "bot-menace-mirror", Name: "MENACE Mirror Bot", Rating: 1100,
+		Bot: &models.BotAccount{Strategy: models.AIStrategyMenace}},
+}
+
+// EnsureBuiltInBots creates the built-in bot players that are missing from
+// the store. Existing players are left alone, so their ratings survive.
+func EnsureBuiltInBots(playerStore store.PlayerStore) error {
+	for _, bot := range builtInBots {
+		if _, err := playerStore.Get(bot.ID); err == nil {
+			continue
+		}
+		player := bot
+		account := *bot.Bot
+		player.Bot = &account
+		if err := playerStore.Create(&player); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// builtInBotID returns the ID of the built-in bot that plays with the given
+// strategy and personality preset ("" for full strength), other than the bot
+// with the ID exclude. It fails with ErrInvalidStrategy if there is no such bot.
+func builtInBotID(strategy models.AIStrategy, personality, exclude string) (string, error) {
+	for _, bot := range builtInBots {
+		if bot.Bot.Strategy == strategy && bot.Bot.Personality == personality && bot.ID != exclude {
+			return bot.ID, nil
+		}
+	}
+	return "", ErrInvalidStrategy
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"tic-tac-go/internal/models"
+	"tic-tac-go/internal/store"
+
+	"github.com/google/uuid"
+)
+
+// challengeService is a concrete implementation of ChallengeService.
+type challengeService struct {
+	challengeStore store.ChallengeStore
+	playerStore    store.PlayerStore
+	gameSvc        GameService
+	broadcaster    PlayerBroadcaster // Optional: nil if not provided
+	notifier       Notifier          // Optional: nil if not provided
+	ttl            time.Duration
+	// mu serialises answers and expiry, so a challenge is settled only once
+	mu sync.Mutex
+}
+
+// NewChallengeService constructs a ChallengeService whose challenges expire
+// after DefaultChallengeTTL. Accepted challenges start their game through gameSvc.
+func NewChallengeService(challengeStore store.ChallengeStore, playerStore store.PlayerStore, gameSvc GameService, broadcaster PlayerBroadcaster) ChallengeService {
+	return NewChallengeServiceWithNotifier(challengeStore, playerStore, gameSvc, broadcaster, nil, DefaultChallengeTTL)
+}
+
+// NewChallengeServiceWithNotifier constructs a ChallengeService that also
+// records new challenges in the target's inbox; challenges expire after ttl.
+func NewChallengeServiceWithNotifier(challengeStore store.ChallengeStore, playerStore store.PlayerStore, gameSvc GameService, broadcaster PlayerBroadcaster, notifier Notifier, ttl time.Duration) ChallengeService {
+	if ttl <= 0 {
+		ttl = DefaultChallengeTTL
+	}
+	return &challengeService{
+		challengeStore: challengeStore,
+		playerStore:    playerStore,
+		gameSvc:        gameSvc,
+		broadcaster:    broadcaster,
+		notifier:       notifier,
+		ttl:            ttl,
+	}
+}
+
+// CreateChallenge invites targetID to a game and pushes the challenge to
+// their player channel. Unanswered challenges expire after the service's TTL.
+func (s *challengeService) CreateChallenge(ctx context.Context, challengerID, targetID string, opts ChallengeOptions) (*models.Challenge, error) {
+	// Ensure both players exist.
+	if _, err := s.playerStore.Get(challengerID); err != nil {
+		return nil, err
+	}
+	target, err := s.playerStore.Get(targetID)
+	if err != nil {
+		return nil, err
+	}
+	if targetID == challengerID || target.Bot != nil {
+		return nil, ErrInvalidChallenge
+	}
+
+	mode := opts.Mode
+	if mode == "" {
+		mode = models.GameModePVP
+	}
+	if mode != models.GameModePVP {
+		return nil, ErrInvalidGameMode
+	}
+	variant := opts.Variant
+	if variant == "" {
+		variant = models.GameVariantClassic
+	}
+	if !validVariant(variant) {
+		return nil, ErrInvalidVariant
+	}
+	if !validTimeControl(opts.TimeControl) {
+		return nil, ErrInvalidTimeControl
+	}
+
+	now := time.Now().UTC()
+	challenge := &models.Challenge{
+		ID:           uuid.NewString(),
+		ChallengerID: challengerID,
+		TargetID:     targetID,
+		Mode:         mode,
+		Variant:      variant,
+		TimeControl:  opts.TimeControl,
+		Status:       models.ChallengeStatusPending,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(s.ttl),
+	}
+	if err := s.challengeStore.Create(challenge); err != nil {
+		return nil, err
+	}
+
+	s.broadcast(challenge)
+	if s.notifier != nil {
+		s.notifier.Challenged(challenge)
+	}
+	time.AfterFunc(s.ttl, func() { s.expire(challenge.ID) })
+
+	return challenge, nil
+}
+
+// AcceptChallenge starts the challenged game: the challenger takes X, the
+// accepting target O. The game is private, so it does not show up in the lobby.
+func (s *challengeService) AcceptChallenge(ctx context.Context, challengeID, playerID string) (*models.Challenge, *models.GameState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	challenge, err := s.pendingChallenge(challengeID, playerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gameState, err := s.gameSvc.CreateGameWithOptions(ctx, challenge.ChallengerID, challenge.Mode, GameOptions{
+		Variant:     challenge.Variant,
+		TimeControl: challenge.TimeControl,
+		Private:     true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	gameState, err = s.gameSvc.JoinGameByCode(ctx, gameState.InviteCode, challenge.TargetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	challenge.Status = models.ChallengeStatusAccepted
+	challenge.GameID = gameState.ID
+	if err := s.challengeStore.Update(challenge); err != nil {
+		return nil, nil, err
+	}
+	s.broadcast(challenge)
+
+	return challenge, gameState, nil
+}
+
+// DeclineChallenge turns the challenge down and tells the challenger.
+func (s *challengeService) DeclineChallenge(ctx context.Context, challengeID, playerID string) (*models.Challenge, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	challenge, err := s.pendingChallenge(challengeID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge.Status = models.ChallengeStatusDeclined
+	if err := s.challengeStore.Update(challenge); err != nil {
+		return nil, err
+	}
+	s.broadcast(challenge)
+
+	return challenge, nil
+}
+
+// ListChallenges returns the pending challenges the player sent or received.
+func (s *challengeService) ListChallenges(ctx context.Context, playerID string) ([]*models.Challenge, error) {
+	if _, err := s.playerStore.Get(playerID); err != nil {
+		return nil, err
+	}
+	status := models.ChallengeStatusPending
+	return s.challengeStore.List(store.ChallengeFilter{PlayerID: playerID, Status: &status})
+}
+
+// pendingChallenge loads a challenge that playerID may still answer. A
+// challenge past its expiry is expired on the spot. The caller holds s.mu.
+func (s *challengeService) pendingChallenge(challengeID, playerID string) (*models.Challenge, error) {
+	challenge, err := s.challengeStore.Get(challengeID)
+	if err != nil {
+		return nil, err
+	}
+	if challenge.TargetID != playerID {
+		return nil, ErrNotChallengeTarget
+	}
+	if challenge.Status != models.ChallengeStatusPending {
+		return nil, ErrChallengeClosed
+	}
+	if time.Now().UTC().After(challenge.ExpiresAt) {
+		s.markExpired(challenge)
+		return nil, ErrChallengeExpired
+	}
+	return challenge, nil
+}
+
+// expire ends a challenge that is still pending when its time is up.
+func (s *challengeService) expire(challengeID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	challenge, err := s.challengeStore.Get(challengeID)
+	if err != nil || challenge.Status != models.ChallengeStatusPending {
+		return
+	}
+	s.markExpired(challenge)
+}
+
+// markExpired stores the challenge as expired and tells both players.
+func (s *challengeService) markExpired(challenge *models.Challenge) {
+	challenge.Status = models.ChallengeStatusExpired
+	if err := s.challengeStore.Update(challenge); err != nil {
+		return
+	}
+	s.broadcast(challenge)
+}
+
+// broadcast pushes the challenge to both players' channels.
+func (s *challengeService) broadcast(challenge *models.Challenge) {
+	if s.broadcaster == nil {
+		return
+	}
+	s.broadcaster.BroadcastChallenge(challenge.ChallengerID, challenge)
+	s.broadcaster.BroadcastChallenge(challenge.TargetID, challenge)
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package service
+
+import (
+	"log"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// armClock starts the timer that ends a timed game once the player to move
+// runs out of time, replacing the timer of the previous turn. Finished and
+// untimed games have no timer.
+func (s *gameService) armClock(gameState *models.GameState) {
+	if timer, ok := s.clockTimers.LoadAndDelete(gameState.ID); ok {
+		timer.(*time.Timer).Stop()
+	}
+	if gameState.Status != models.GameStatusInProgress {
+		return
+	}
+	left, ok := game.RemainingTime(gameState, gameState.CurrentTurn, time.Now())
+	if !ok {
+		return
+	}
+	gameID := gameState.ID
+	s.clockTimers.Store(gameID, time.AfterFunc(left, func() { s.flagFall(gameID) }))
+}
+
+// flagFall ends the game if the player to move has run out of time. A timer
+// of a turn that has already ended finds the clock still running and only
+// re-arms the current one.
+func (s *gameService) flagFall(gameID string) {
+	unlock := s.lockGame(gameID)
+	defer unlock()
+
+	gameState, err := s.gameStore.Get(gameID)
+	if err != nil || gameState.Status != models.GameStatusInProgress {
+		return
+	}
+	now := time.Now().UTC()
+	if left, ok := game.RemainingTime(gameState, gameState.CurrentTurn, now); !ok || left > 0 {
+		s.armClock(gameState)
+		return
+	}
+	if err := s.loseOnTime(gameState, gameState.CurrentTurn, now); err != nil {
+		log.Printf("ending game %s on time failed: %v", gameID, err)
+	}
+}
+
+// loseOnTime finishes the game as lost by seat, whose clock ran out, and
+// tells everybody watching. The caller holds the game lock.
+func (s *gameService) loseOnTime(gameState *models.GameState, seat models.Symbol, now time.Time) error {
+	gameState.Clocks[seat] = 0
+	gameState.Status = models.GameStatusFinished
+	gameState.Winner = string(game.OppositeSymbol(seat))
+	gameState.ForfeitedBy = seat
+	gameState.UpdatedAt = now
+	if err := s.gameStore.Update(gameState); err != nil {
+		return err
+	}
+	s.armClock(gameState)
+
+	if s.broadcaster != nil {
+		s.broadcaster.BroadcastGameState(gameState.ID, gameState)
+	}
+	if s.notifier != nil {
+		s.notifier.GameUpdated(gameState)
+	}
+	s.presenceChanged(gameState)
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+	"tic-tac-go/internal/store"
+)
+
+// friendService is a concrete implementation of FriendService.
+type friendService struct {
+	playerStore store.PlayerStore
+	gameStore   store.GameStore
+	presence    PresenceSource    // Optional: nil reports everybody offline
+	broadcaster PlayerBroadcaster // Optional: nil if not provided
+	// mu serialises changes to friend lists, which touch two players
+	mu sync.Mutex
+}
+
+// NewFriendService constructs a FriendService that derives presence from
+// the given source and pushes presence changes through broadcaster.
+func NewFriendService(playerStore store.PlayerStore, gameStore store.GameStore, presence PresenceSource, broadcaster PlayerBroadcaster) FriendService {
+	return &friendService{
+		playerStore: playerStore,
+		gameStore:   gameStore,
+		presence:    presence,
+		broadcaster: broadcaster,
+	}
+}
+
+// AddFriend makes the two players friends of each other. Adding an existing
+// friend is a no-op.
+func (s *friendService) AddFriend(ctx context.Context, playerID, friendID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	player, friend, err := s.friendPair(playerID, friendID)
+	if err != nil {
+		return err
+	}
+	if friend.Bot != nil {
+		return ErrInvalidFriend
+	}
+	if err := s.playerStore.UpdateFriends(playerID, addID(player.Friends, friendID)); err != nil {
+		return err
+	}
+	return s.playerStore.UpdateFriends(friendID, addID(friend.Friends, playerID))
+}
+
+// RemoveFriend ends the friendship on both sides. Removing a player who is
+// not a friend is a no-op.
+func (s *friendService) RemoveFriend(ctx context.Context, playerID, friendID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	player, friend, err := s.friendPair(playerID, friendID)
+	if err != nil {
+		return err
+	}
+	if err := s.playerStore.UpdateFriends(playerID, removeID(player.Friends, friendID)); err != nil {
+		return err
+	}
+	return s.playerStore.UpdateFriends(friendID, removeID(friend.Friends, playerID))
+}
+
+// ListFriends returns the presence of every friend of the player, online
+// friends first.
+func (s *friendService) ListFriends(ctx context.Context, playerID string) ([]*models.Presence, error) {
+	player, err := s.playerStore.Get(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	friends := make([]*models.Presence, 0, len(player.Friends))
+	for _, id := range player.Friends {
+		friend, err := s.playerStore.Get(id)
+		if err != nil {
+			continue
+		}
+		friends = append(friends, s.presenceOf(friend))
+	}
+	sort.SliceStable(friends, func(i, j int) bool {
+		if online := friends[i].Status != models.PresenceOffline; online != (friends[j].Status != models.PresenceOffline) {
+			return online
+		}
+		return friends[i].Name < friends[j].Name
+	})
+	return friends, nil
+}
+
+// PresenceChanged pushes the player's current presence to all their friends.
+func (s *friendService) PresenceChanged(ctx context.Context, playerID string) {
+	if s.broadcaster == nil {
+		return
+	}
+	player, err := s.playerStore.Get(playerID)
+	if err != nil || len(player.Friends) == 0 {
+		return
+	}
+	presence := s.presenceOf(player)
+	for _, friendID := range player.Friends {
+		s.broadcaster.BroadcastPresence(friendID, presence)
+	}
+}
+
+// presenceOf derives a player's presence: IN_GAME while connected to a
+// running game they play in, ONLINE while connected otherwise.
+func (s *friendService) presenceOf(player *models.Player) *models.Presence {
+	presence := &models.Presence{PlayerID: player.ID, Name: player.Name, Status: models.PresenceOffline}
+	if s.presence == nil {
+		return presence
+	}
+	online, gameIDs := s.presence.Presence(player.ID)
+	if !online {
+		return presence
+	}
+
+	presence.Status = models.PresenceOnline
+	for _, gameID := range gameIDs {
+		gameState, err := s.gameStore.Get(gameID)
+		if err != nil || gameState.Status != models.GameStatusInProgress {
+			continue
+		}
+		if game.SymbolForPlayer(gameState, player.ID) != models.SymbolEmpty {
+			presence.Status = models.PresenceInGame
+			presence.GameID = gameID
+			break
+		}
+	}
+	return presence
+}
+
+// friendPair loads both sides of a friendship.
+func (s *friendService) friendPair(playerID, friendID string) (*models.Player, *models.Player, error) {
+	if playerID == friendID {
+		return nil, nil, ErrInvalidFriend
+	}
+	player, err := s.playerStore.Get(playerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	friend, err := s.playerStore.Get(friendID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return player, friend, nil
+}
+
+// addID returns a new list with id appended unless it is already present.
+func addID(ids []string, id string) []string {
+	for _, existing := range ids {
+		if existing == id {
+			return ids
+		}
+	}
+	return append(append([]string{}, ids...), id)
+}
+
+// removeID returns a new list without id.
+func removeID(ids []string, id string) []string {
+	result := []string{}
+	for _, existing := range ids {
+		if existing != id {
+			result = append(result, existing)
+		}
+	}
+	return result
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+)
+
+// ExportGame builds the portable record of a game. Running fog-of-war games
+// cannot be exported because the record would reveal hidden marks.
+func (s *gameService) ExportGame(ctx context.Context, gameID string) (*game.GameRecord, error) {
+	gameState, err := s.gameStore.Get(gameID)
+	if err != nil {
+		return nil, err
+	}
+	if gameState.Variant == models.GameVariantFogOfWar && gameState.Status != models.GameStatusFinished {
+		return nil, ErrInvalidGameState
+	}
+
+	return game.NewGameRecord(gameState, s.playerName(gameState.PlayerXID), s.playerName(gameState.PlayerOID)), nil
+}
+
+// playerName returns the name of a player, or the ID itself for players that
+// are not in the store (e.g. the AI).
+func (s *gameService) playerName(playerID string) string {
+	if playerID == "" {
+		return ""
+	}
+	player, err := s.playerStore.Get(playerID)
+	if err != nil {
+		return playerID
+	}
+	return player.Name
+}
+
+// ImportGame creates a new game from a record by replaying its moves. The
+// importing player takes seat X; an unfinished PVP game waits for a second
+// player to join as O, an unfinished PVC game continues against the AI.
+func (s *gameService) ImportGame(ctx context.Context, playerID string, record *game.GameRecord) (*models.GameState, error) {
+	opts := GameOptions{
+		Variant: record.Variant,
+		Role:    record.XRole,
+		Torus:   record.Torus,
+	}
+	if record.Setup != nil {
+		if len(record.Setup) != game.BoardSize(record.Variant) {
+			return nil, ErrInvalidRecord
+		}
+		for r := range record.Setup {
+			for c, cell := range record.Setup[r] {
+				switch cell {
+				case models.SymbolEmpty:
+				case models.SymbolBlocked:
+					opts.BlockedCells = append(opts.BlockedCells, [2]int{r, c})
+				default:
+					return nil, ErrInvalidRecord
+				}
+			}
+		}
+	}
+
+	gameState, err := s.newGameState(playerID, record.Mode, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Replay the moves exactly as PlayMove would have applied them.
+	gameState.Status = models.GameStatusInProgress
+	for _, m := range record.Moves {
+		if gameState.Status != models.GameStatusInProgress || m.Seat != gameState.CurrentTurn {
+			return nil, ErrInvalidRecord
+		}
+		newBoard, err := game.ApplyVariantMove(gameState, m.Seat, m.Move)
+		if err != nil {
+			return nil, ErrInvalidRecord
+		}
+		gameState.Board = newBoard
+		recordMove(gameState, m.Seat, m.Move)
+		updateOutcome(gameState, m.Seat)
+	}
+	// A forfeit ends a game that was still running after the last move.
+	if record.ForfeitedBy != "" {
+		if gameState.Status != models.GameStatusInProgress {
+			return nil, ErrInvalidRecord
+		}
+		gameState.Status = models.GameStatusFinished
+		gameState.Winner = string(game.OppositeSymbol(record.ForfeitedBy))
+		gameState.ForfeitedBy = record.ForfeitedBy
+	}
+	if game.RecordResult(gameState) != record.Result {
+		return nil, ErrInvalidRecord
+	}
+
+	if gameState.Status == models.GameStatusInProgress && gameState.Mode == models.GameModePVP {
+		gameState.Status = models.GameStatusWaitingForPlayer
+	}
+	if !s.playsAsync(gameState) {
+		s.playAIReply(ctx, gameState)
+	}
+	gameState.UpdatedAt = time.Now().UTC()
+
+	if err := s.gameStore.Create(gameState); err != nil {
+		return nil, err
+	}
+
+	// Broadcast state change to WebSocket clients
+	if s.broadcaster != nil {
+		s.broadcaster.BroadcastGameState(gameState.ID, gameState)
+	}
+	s.startAIReply(gameState)
+
+	return gameState, nil
+}
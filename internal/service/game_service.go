@@ -20,6 +20,10 @@ package service
 
 import (
 	"context"
+	"hash/fnv"
+	"log"
+	"math/rand"
+	"sync"
 	"tic-tac-go/internal/ai"
 	"tic-tac-go/internal/game"
 	"tic-tac-go/internal/models"
@@ -34,14 +38,38 @@ type gameService struct {
 	gameStore   store.GameStore
 	playerStore store.PlayerStore
 	broadcaster GameStateBroadcaster // Optional: nil if not provided
+	menace      *ai.Menace           // Optional: nil disables the MENACE strategy
+	// learnFromHumans lets MENACE keep learning from finished PVC games
+	learnFromHumans bool
+	// asyncAI plays AI moves in the background after aiMoveDelay
+	asyncAI     bool
+	aiMoveDelay time.Duration
+	// botClient asks external bots for moves; bots forfeit after botMoveTimeout
+	botClient      BotClient
+	botMoveTimeout time.Duration
+	// inviteTTL is how long invite codes of private games are valid
+	inviteTTL time.Duration
+	notifier  Notifier         // Optional: nil if not provided
+	presence  PresenceNotifier // Optional: nil if not provided
+	// clockTimers holds the *time.Timer per game ID that ends a timed game
+	// when the player to move runs out of time
+	clockTimers sync.Map
+	// gameLocks serialise moves, so a background AI move cannot interleave
+	// with a player's move. Games share the locks by a hash of their ID, so
+	// no lock is left behind once a game ends.
+	gameLocks [gameLockStripes]sync.Mutex
 }
 
+// gameLockStripes is the number of locks shared by all games.
+const gameLockStripes = 64
+
 // NewGameService constructs a GameService with the given dependencies.
 func NewGameService(gameStore store.GameStore, playerStore store.PlayerStore) GameService {
 	return &gameService{
 		gameStore:   gameStore,
 		playerStore: playerStore,
 		broadcaster: nil,
+		inviteTTL:   DefaultInviteTTL,
 	}
 }
 
@@ -51,58 +79,387 @@ func NewGameServiceWithBroadcaster(gameStore store.GameStore, playerStore store.
 		gameStore:   gameStore,
 		playerStore: playerStore,
 		broadcaster: broadcaster,
+		inviteTTL:   DefaultInviteTTL,
+	}
+}
+
+// NewGameServiceWithConfig constructs a GameService with the given optional settings.
+func NewGameServiceWithConfig(gameStore store.GameStore, playerStore store.PlayerStore, broadcaster GameStateBroadcaster, cfg GameServiceConfig) GameService {
+	if cfg.BotMoveTimeout <= 0 {
+		cfg.BotMoveTimeout = DefaultBotMoveTimeout
+	}
+	if cfg.InviteTTL <= 0 {
+		cfg.InviteTTL = DefaultInviteTTL
+	}
+	return &gameService{
+		gameStore:       gameStore,
+		playerStore:     playerStore,
+		broadcaster:     broadcaster,
+		menace:          cfg.Menace,
+		learnFromHumans: cfg.LearnFromHumans,
+		asyncAI:         cfg.AsyncAI,
+		aiMoveDelay:     cfg.AIMoveDelay,
+		botClient:       cfg.BotClient,
+		botMoveTimeout:  cfg.BotMoveTimeout,
+		inviteTTL:       cfg.InviteTTL,
+		notifier:        cfg.Notifier,
+		presence:        cfg.Presence,
 	}
 }
 
-// CreateGame creates a new game in either PVP or PVC mode.
+// CreateGame creates a new classic game in either PVP or PVC mode.
 func (s *gameService) CreateGame(ctx context.Context, creatorPlayerID string, mode models.GameMode) (*models.GameState, error) {
+	return s.CreateGameWithOptions(ctx, creatorPlayerID, mode, GameOptions{})
+}
+
+// CreateGameWithOptions creates a new game in either PVP or PVC mode using the given options.
+func (s *gameService) CreateGameWithOptions(ctx context.Context, creatorPlayerID string, mode models.GameMode, opts GameOptions) (*models.GameState, error) {
+	gameState, err := s.newGameState(creatorPlayerID, mode, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the AI holds X, it opens the game.
+	if !s.playsAsync(gameState) {
+		s.playAIReply(ctx, gameState)
+	}
+
+	if err := s.gameStore.Create(gameState); err != nil {
+		return nil, err
+	}
+
+	// Broadcast state change to WebSocket clients
+	if s.broadcaster != nil {
+		s.broadcaster.BroadcastGameState(gameState.ID, gameState)
+	}
+	s.startAIReply(gameState)
+
+	return gameState, nil
+}
+
+// newGameState validates the options and builds the starting state of a new
+// game without storing it.
+func (s *gameService) newGameState(creatorPlayerID string, mode models.GameMode, opts GameOptions) (*models.GameState, error) {
 	// Ensure creator exists.
-	if _, err := s.playerStore.Get(creatorPlayerID); err != nil {
+	creator, err := s.playerStore.Get(creatorPlayerID)
+	if err != nil {
 		return nil, err
 	}
 
-	if mode != models.GameModePVP && mode != models.GameModePVC {
+	if mode != models.GameModePVP && mode != models.GameModePVC && mode != models.GameModeCVC {
 		return nil, ErrInvalidGameMode
 	}
+	// Only a human opponent can be invited.
+	if opts.Private && mode != models.GameModePVP {
+		return nil, ErrInvalidGameMode
+	}
+
+	variant := opts.Variant
+	if variant == "" {
+		variant = models.GameVariantClassic
+	}
+	if !validVariant(variant) {
+		return nil, ErrInvalidVariant
+	}
+	// Only two people share a clock; the computer does not play on time.
+	if !validTimeControl(opts.TimeControl) || (opts.TimeControl != nil && mode != models.GameModePVP) {
+		return nil, ErrInvalidTimeControl
+	}
+
+	if opts.AIIterations < 0 || opts.AIIterations > MaxAIIterations ||
+		opts.AITimeLimit < 0 || opts.AITimeLimit > MaxAITimeLimit {
+		return nil, ErrInvalidStrategy
+	}
+	strategy := opts.AIStrategy
+	if strategy == "" {
+		strategy = models.AIStrategyHeuristic
+	}
+	if !s.validStrategy(strategy, variant, opts) {
+		return nil, ErrInvalidStrategy
+	}
+
+	// An opponent chosen by ID is a bot player: an external engine, or a
+	// built-in bot that brings its own strategy and personality.
+	personalityName := opts.Personality
+	if opts.OpponentID != "" {
+		opponent, err := s.playerStore.Get(opts.OpponentID)
+		if err != nil || opponent.Bot == nil || mode != models.GameModePVC ||
+			opponent.ID == creatorPlayerID || opts.AIStrategy != "" ||
+			opts.Personality != "" || opts.AdaptiveDifficulty {
+			return nil, ErrInvalidOpponent
+		}
+		if opponent.Bot.CallbackURL != "" {
+			if s.botClient == nil || variant == models.GameVariantFogOfWar {
+				return nil, ErrInvalidOpponent
+			}
+			strategy = models.AIStrategyExternal
+		} else {
+			strategy, personalityName = opponent.Bot.Strategy, opponent.Bot.Personality
+			if !s.validStrategy(strategy, variant, opts) {
+				return nil, ErrInvalidStrategy
+			}
+		}
+	}
+
+	// In CVC, AIStrategy plays X and OpponentAIStrategy plays O.
+	var strategies map[models.Symbol]models.AIStrategy
+	pace := opts.MovePace
+	if mode == models.GameModeCVC {
+		opponent := opts.OpponentAIStrategy
+		if opponent == "" {
+			opponent = strategy
+		}
+		if !s.validStrategy(opponent, variant, opts) {
+			return nil, ErrInvalidStrategy
+		}
+		strategies = map[models.Symbol]models.AIStrategy{
+			models.SymbolX: strategy,
+			models.SymbolO: opponent,
+		}
+		if pace == 0 {
+			pace = DefaultMovePace
+		}
+		if pace < 0 || pace > MaxMovePace {
+			return nil, ErrInvalidPace
+		}
+	} else if opts.OpponentAIStrategy != "" {
+		return nil, ErrInvalidStrategy
+	} else if pace != 0 {
+		return nil, ErrInvalidPace
+	}
+
+	var personality *models.Personality
+	if personalityName != "" {
+		preset, ok := ai.PersonalityPreset(personalityName)
+		if !ok || mode != models.GameModePVC || strategy != models.AIStrategyHeuristic {
+			return nil, ErrInvalidPersonality
+		}
+		personality = &preset
+	}
+
+	humanSeat, err := creatorSeat(mode, opts.PlayAs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The adaptive opponent picks its personality from the creator's level.
+	var difficulty *models.Difficulty
+	if opts.AdaptiveDifficulty {
+		if mode != models.GameModePVC || strategy != models.AIStrategyHeuristic || personality != nil {
+			return nil, ErrInvalidDifficulty
+		}
+		d := ai.NewDifficulty()
+		if creator.Difficulty != nil {
+			d = *creator.Difficulty
+		}
+		preset := ai.DifficultyPersonality(d.Level)
+		difficulty, personality = &d, &preset
+	}
+
+	// Without a chosen opponent, the built-in bot matching the strategy and
+	// personality plays.
+	opponentID := opts.OpponentID
+	if opponentID == "" {
+		name := ""
+		if personality != nil {
+			name = personality.Name
+		}
+		opponentID, err = builtInBotID(strategy, name, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	board, err := newBoardWithBlockedCells(variant, opts)
+	if err != nil {
+		return nil, err
+	}
 
 	now := time.Now().UTC()
 
 	gameState := &models.GameState{
-		ID:        uuid.NewString(),
-		Mode:      mode,
-		Board:     game.NewBoard(),
-		PlayerXID: creatorPlayerID,
-		Status:    models.GameStatusInProgress,
-		Winner:    "",
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:            uuid.NewString(),
+		Mode:          mode,
+		Variant:       variant,
+		Board:         board,
+		Torus:         opts.Torus,
+		PlayerXID:     creatorPlayerID,
+		HintsDisabled: opts.DisableHints,
+		AIStrategy:    strategy,
+		AIIterations:  opts.AIIterations,
+		AITimeLimit:   opts.AITimeLimit,
+		Personality:   personality,
+		Difficulty:    difficulty,
+		Status:        models.GameStatusInProgress,
+		Winner:        "",
+		AIStrategies:  strategies,
+		MovePace:      pace,
+		CreatedBy:     creatorPlayerID,
+		TimeControl:   opts.TimeControl,
+		CreatedAt:     now,
+		UpdatedAt:     now,
 	}
 
 	// For PVP, wait for second player.
 	if mode == models.GameModePVP {
 		gameState.Status = models.GameStatusWaitingForPlayer
 	}
-
-	// For PVC, PlayerO is the AI.
-	if mode == models.GameModePVC {
-		gameState.PlayerOID = "AI"
-		gameState.CurrentTurn = models.SymbolX
-	} else {
-		gameState.CurrentTurn = models.SymbolX
+	if opts.Private {
+		code, err := s.newInviteCode()
+		if err != nil {
+			return nil, err
+		}
+		gameState.Private = true
+		gameState.InviteCode = code
+		gameState.InviteExpiresAt = now.Add(s.inviteTTL)
 	}
 
-	if err := s.gameStore.Create(gameState); err != nil {
-		return nil, err
+	// For PVC, the opponent bot takes the seat the creator did not choose;
+	// in CVC bots take both.
+	gameState.CurrentTurn = models.SymbolX
+	switch mode {
+	case models.GameModePVC:
+		if humanSeat == models.SymbolO {
+			gameState.PlayerXID, gameState.PlayerOID = opponentID, creatorPlayerID
+		} else {
+			gameState.PlayerOID = opponentID
+		}
+	case models.GameModeCVC:
+		// A bot never plays itself: in a mirror match O gets the mirror bot.
+		if gameState.PlayerXID, err = builtInBotID(strategies[models.SymbolX], "", ""); err != nil {
+			return nil, err
+		}
+		if gameState.PlayerOID, err = builtInBotID(strategies[models.SymbolO], "", gameState.PlayerXID); err != nil {
+			return nil, err
+		}
 	}
 
-	// Broadcast state change to WebSocket clients
-	if s.broadcaster != nil {
-		s.broadcaster.BroadcastGameState(gameState.ID, gameState)
+	// In Order and Chaos the creator picks a role (ORDER by default) and the
+	// second seat takes the other one.
+	if variant == models.GameVariantOrderAndChaos {
+		role := opts.Role
+		if role == "" {
+			role = models.RoleOrder
+		}
+		if role != models.RoleOrder && role != models.RoleChaos {
+			return nil, ErrInvalidRole
+		}
+		gameState.Roles = map[models.Symbol]models.Role{
+			humanSeat:                      role,
+			game.OppositeSymbol(humanSeat): game.OppositeRole(role),
+		}
 	}
 
 	return gameState, nil
 }
 
+// creatorSeat resolves the seat the creator asked for. Only PVC games let the
+// creator choose; in PVP the creator always takes X.
+func creatorSeat(mode models.GameMode, playAs string) (models.Symbol, error) {
+	switch playAs {
+	case "", string(models.SymbolX):
+		return models.SymbolX, nil
+	case string(models.SymbolO), PlayAsRandom:
+		if mode != models.GameModePVC {
+			return models.SymbolEmpty, ErrInvalidSeat
+		}
+		if playAs == PlayAsRandom && rand.Intn(2) == 0 {
+			return models.SymbolX, nil
+		}
+		return models.SymbolO, nil
+	default:
+		return models.SymbolEmpty, ErrInvalidSeat
+	}
+}
+
+// validVariant reports whether the server knows the rule set.
+func validVariant(variant models.GameVariant) bool {
+	switch variant {
+	case models.GameVariantClassic, models.GameVariantFogOfWar, models.GameVariantOrderAndChaos,
+		models.GameVariantThreeMensMorris, models.GameVariantWild, models.GameVariantNumerical:
+		return true
+	}
+	return false
+}
+
+// validTimeControl reports whether tc is either unset or a usable clock.
+func validTimeControl(tc *models.TimeControl) bool {
+	return tc == nil || (tc.Initial > 0 && tc.Initial <= MaxTimeControl && tc.Increment >= 0 && tc.Increment <= tc.Initial)
+}
+
+// validStrategy reports whether an AI strategy can play the variant with the
+// given board options.
+func (s *gameService) validStrategy(strategy models.AIStrategy, variant models.GameVariant, opts GameOptions) bool {
+	switch strategy {
+	case models.AIStrategyHeuristic:
+		return true
+	case models.AIStrategyMCTS:
+		// MCTS needs the full board, which the AI must not see in fog-of-war.
+		return variant != models.GameVariantFogOfWar
+	case models.AIStrategyMenace:
+		// MENACE only knows the plain classic board.
+		return s.menace != nil && variant == models.GameVariantClassic &&
+			!opts.Torus && len(opts.BlockedCells) == 0 && opts.RandomBlockedCells == 0
+	default:
+		return false
+	}
+}
+
+// aiSeat returns the seat of the computer opponent (the built-in AI or a bot)
+// in a PVC game, the seat on turn in a CVC game, or models.SymbolEmpty if no
+// seat is played by the computer.
+func aiSeat(gameState *models.GameState) models.Symbol {
+	switch gameState.Mode {
+	case models.GameModePVC:
+		// The creator is the human; the computer holds the other seat.
+		return game.OppositeSymbol(game.SymbolForPlayer(gameState, gameState.CreatedBy))
+	case models.GameModeCVC:
+		return gameState.CurrentTurn
+	default:
+		return models.SymbolEmpty
+	}
+}
+
+// aiStrategy returns the strategy the AI plays with in the given seat.
+func aiStrategy(gameState *models.GameState, seat models.Symbol) models.AIStrategy {
+	if strategy, ok := gameState.AIStrategies[seat]; ok {
+		return strategy
+	}
+	return gameState.AIStrategy
+}
+
+// newBoardWithBlockedCells creates the starting board for a variant with the
+// requested fixed and random cells blocked. At most a third of the board may
+// be blocked so that the game stays playable.
+func newBoardWithBlockedCells(variant models.GameVariant, opts GameOptions) (models.Board, error) {
+	board := game.NewBoardForVariant(variant)
+	size := len(board)
+
+	if opts.RandomBlockedCells < 0 || len(opts.BlockedCells)+opts.RandomBlockedCells > size*size/3 {
+		return nil, ErrInvalidBoard
+	}
+
+	board, err := game.BlockCells(board, opts.BlockedCells)
+	if err != nil {
+		return nil, ErrInvalidBoard
+	}
+
+	if opts.RandomBlockedCells > 0 {
+		// Choose among the cells that are still free.
+		free := game.AvailableMoves(board)
+		rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+		var cells [][2]int
+		for _, i := range rng.Perm(len(free))[:opts.RandomBlockedCells] {
+			cells = append(cells, free[i])
+		}
+		if board, err = game.BlockCells(board, cells); err != nil {
+			return nil, ErrInvalidBoard
+		}
+	}
+
+	return board, nil
+}
+
 // GetGame returns the current state of a game by ID.
 func (s *gameService) GetGame(ctx context.Context, gameID string) (*models.GameState, error) {
 	return s.gameStore.Get(gameID)
@@ -118,6 +475,23 @@ func (s *gameService) JoinGame(ctx context.Context, gameID, playerID string) (*m
 	if err != nil {
 		return nil, err
 	}
+	if gameState.Private {
+		return nil, ErrPrivateGame
+	}
+
+	return s.seatSecondPlayer(gameState, playerID)
+}
+
+// seatSecondPlayer lets playerID take seat O of a waiting PVP game.
+func (s *gameService) seatSecondPlayer(gameState *models.GameState, playerID string) (*models.GameState, error) {
+	unlock := s.lockGame(gameState.ID)
+	defer unlock()
+
+	// Another player may have joined since the game was loaded.
+	gameState, err := s.gameStore.Get(gameState.ID)
+	if err != nil {
+		return nil, err
+	}
 
 	// Game must be PVP and waiting.
 	if gameState.Mode != models.GameModePVP || gameState.Status != models.GameStatusWaitingForPlayer {
@@ -127,8 +501,12 @@ func (s *gameService) JoinGame(ctx context.Context, gameID, playerID string) (*m
 	// Joining player becomes O.
 	gameState.PlayerOID = playerID
 	gameState.Status = models.GameStatusInProgress
-	gameState.CurrentTurn = models.SymbolX
+	// X moves first unless the game was imported with moves already played.
+	if len(gameState.History) == 0 {
+		gameState.CurrentTurn = models.SymbolX
+	}
 	gameState.UpdatedAt = time.Now().UTC()
+	game.StartClocks(gameState, gameState.UpdatedAt)
 
 	if err := s.gameStore.Update(gameState); err != nil {
 		return nil, err
@@ -136,13 +514,28 @@ func (s *gameService) JoinGame(ctx context.Context, gameID, playerID string) (*m
 
 	// Broadcast state change to WebSocket clients
 	if s.broadcaster != nil {
-		s.broadcaster.BroadcastGameState(gameID, gameState)
+		s.broadcaster.BroadcastGameState(gameState.ID, gameState)
+	}
+	if s.notifier != nil {
+		s.notifier.GameUpdated(gameState)
 	}
+	// Both players are now in a running game.
+	s.presenceChanged(gameState)
+	s.armClock(gameState)
 
 	return gameState, nil
 }
 
+// MakeMove places the player's own symbol at (row, col).
 func (s *gameService) MakeMove(ctx context.Context, gameID, playerID string, row, col int) (*models.GameState, error) {
+	return s.PlayMove(ctx, gameID, playerID, models.Move{Row: row, Col: col})
+}
+
+// PlayMove applies a move in any variant and, in PVC mode, the AI's reply.
+func (s *gameService) PlayMove(ctx context.Context, gameID, playerID string, move models.Move) (*models.GameState, error) {
+	unlock := s.lockGame(gameID)
+	defer unlock()
+
 	// Load game.
 	gameState, err := s.gameStore.Get(gameID)
 	if err != nil {
@@ -156,72 +549,64 @@ func (s *gameService) MakeMove(ctx context.Context, gameID, playerID string, row
 
 	// Determine symbol for this player and ensure they are a participant.
 	var symbol models.Symbol
-	var opponentSymbol models.Symbol
 
 	if playerID == gameState.PlayerXID {
 		symbol = models.SymbolX
-		opponentSymbol = models.SymbolO
 	} else if playerID == gameState.PlayerOID {
 		symbol = models.SymbolO
-		opponentSymbol = models.SymbolX
 	} else {
 		return nil, ErrNotParticipant
 	}
+	// Nobody may move on behalf of the computer (the built-in AI or a bot).
+	if gameState.Mode == models.GameModeCVC || symbol == aiSeat(gameState) {
+		return nil, ErrNotParticipant
+	}
 
 	// Enforce turn order.
 	if gameState.CurrentTurn != symbol {
 		return nil, ErrNotPlayersTurn
 	}
 
-	// Validate move.
-	if !game.IsValidMove(gameState.Board, row, col) {
-		return nil, ErrInvalidMove
+	// A player whose flag has fallen loses on time instead of moving.
+	now := time.Now().UTC()
+	if left, ok := game.RemainingTime(gameState, symbol, now); ok && left == 0 {
+		if err := s.loseOnTime(gameState, symbol, now); err != nil {
+			return nil, err
+		}
+		return nil, ErrTimeExpired
+	}
+
+	// In fog-of-war, moving into a hidden opponent mark reveals it to the
+	// player instead of ending the turn; the player then tries again.
+	if gameState.Variant == models.GameVariantFogOfWar && revealHiddenCell(gameState, symbol, move.Row, move.Col) {
+		gameState.UpdatedAt = now
+		if err := s.gameStore.Update(gameState); err != nil {
+			return nil, err
+		}
+		if s.broadcaster != nil {
+			s.broadcaster.BroadcastGameState(gameID, gameState)
+		}
+		return nil, ErrHiddenCellTaken
 	}
 
-	// Apply player's move.
-	newBoard, err := game.ApplyMove(gameState.Board, row, col, symbol)
+	// Validate and apply player's move.
+	newBoard, err := game.ApplyVariantMove(gameState, symbol, move)
 	if err != nil {
 		return nil, ErrInvalidMove
 	}
 	gameState.Board = newBoard
+	recordMove(gameState, symbol, move)
+	game.PressClock(gameState, symbol, now)
 
 	// Check winner / draw after player's move.
-	winner, isDraw := game.CheckWinner(gameState.Board)
-	if winner != models.SymbolEmpty {
-		gameState.Status = models.GameStatusFinished
-		gameState.Winner = string(winner)
-	} else if isDraw {
-		gameState.Status = models.GameStatusFinished
-		gameState.Winner = "DRAW"
-	} else {
-		// Switch turn.
-		gameState.CurrentTurn = game.OppositeSymbol(symbol)
-	}
+	updateOutcome(gameState, symbol)
 
 	// If PVC and still in progress and it's AI's turn, let AI move.
-	if gameState.Mode == models.GameModePVC &&
-		gameState.Status == models.GameStatusInProgress &&
-		gameState.CurrentTurn == opponentSymbol {
-
-		aiRow, aiCol := ai.ChooseMove(gameState.Board, opponentSymbol, symbol)
-
-		aiBoard, err := game.ApplyMove(gameState.Board, aiRow, aiCol, opponentSymbol)
-		if err == nil {
-			gameState.Board = aiBoard
-
-			winner, isDraw = game.CheckWinner(gameState.Board)
-			if winner != models.SymbolEmpty {
-				gameState.Status = models.GameStatusFinished
-				gameState.Winner = string(winner)
-			} else if isDraw {
-				gameState.Status = models.GameStatusFinished
-				gameState.Winner = "DRAW"
-			} else {
-				// Back to human.
-				gameState.CurrentTurn = symbol
-			}
-		}
+	if !s.playsAsync(gameState) {
+		s.playAIReply(ctx, gameState)
 	}
+	s.learnFromGame(gameState)
+	s.adaptDifficulty(gameState)
 
 	gameState.UpdatedAt = time.Now().UTC()
 
@@ -233,10 +618,312 @@ func (s *gameService) MakeMove(ctx context.Context, gameID, playerID string, row
 	if s.broadcaster != nil {
 		s.broadcaster.BroadcastGameState(gameID, gameState)
 	}
+	if s.notifier != nil {
+		s.notifier.GameUpdated(gameState)
+	}
+	if gameState.Status == models.GameStatusFinished {
+		s.presenceChanged(gameState)
+	}
+	s.armClock(gameState)
+	s.startAIReply(gameState)
 
 	return gameState, nil
 }
 
+// GetHint suggests a move for the player whose turn it is and counts the hint
+// on the game. Hints are only offered in classic games.
+func (s *gameService) GetHint(ctx context.Context, gameID, playerID string) (*models.Hint, error) {
+	// The hint must match the stored position and the counter must not lose
+	// increments, so the whole request runs under the game lock.
+	unlock := s.lockGame(gameID)
+	defer unlock()
+
+	gameState, err := s.gameStore.Get(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := game.SymbolForPlayer(gameState, playerID)
+	if symbol == models.SymbolEmpty {
+		return nil, ErrNotParticipant
+	}
+	if gameState.Status != models.GameStatusInProgress {
+		return nil, ErrInvalidGameState
+	}
+	if gameState.CurrentTurn != symbol {
+		return nil, ErrNotPlayersTurn
+	}
+	if gameState.HintsDisabled {
+		return nil, ErrHintsDisabled
+	}
+	if gameState.Variant != models.GameVariantClassic {
+		return nil, ErrHintsUnavailable
+	}
+
+	hint := ai.SuggestMove(gameState.Board, symbol, game.OppositeSymbol(symbol), gameState.Torus)
+
+	if gameState.HintsUsed == nil {
+		gameState.HintsUsed = make(map[models.Symbol]int)
+	}
+	gameState.HintsUsed[symbol]++
+	gameState.UpdatedAt = time.Now().UTC()
+	if err := s.gameStore.Update(gameState); err != nil {
+		return nil, err
+	}
+
+	return &hint, nil
+}
+
+// playAIReply lets the AI move if the game is a running PVC game and it is
+// the AI's turn.
+func (s *gameService) playAIReply(ctx context.Context, gameState *models.GameState) {
+	seat := aiSeat(gameState)
+	if seat == models.SymbolEmpty ||
+		gameState.Status != models.GameStatusInProgress ||
+		gameState.CurrentTurn != seat {
+		return
+	}
+
+	thinkLikeHuman(ctx, gameState.Personality)
+	aiMove := s.chooseAIMove(ctx, gameState, seat, game.OppositeSymbol(seat))
+
+	aiBoard, err := game.ApplyVariantMove(gameState, seat, aiMove)
+	if err == nil {
+		gameState.Board = aiBoard
+		recordMove(gameState, seat, aiMove)
+
+		// Back to human unless the game is over.
+		updateOutcome(gameState, seat)
+	}
+}
+
+// playsAsync reports whether the AI moves of a game are played in the
+// background. CVC games always are, as nobody waits for their moves.
+func (s *gameService) playsAsync(gameState *models.GameState) bool {
+	return s.asyncAI || gameState.Mode == models.GameModeCVC ||
+		gameState.AIStrategy == models.AIStrategyExternal
+}
+
+// startAIReply plays the AI's move in the background if the game's AI moves
+// are asynchronous and it is the AI's turn. Listeners first receive an
+// ai_thinking event; after the configured delay (the game's pace in CVC) the
+// move is stored and broadcast like any other move. A CVC game keeps going
+// until it is finished. The game must already be stored.
+func (s *gameService) startAIReply(gameState *models.GameState) {
+	seat := aiSeat(gameState)
+	if !s.playsAsync(gameState) || seat == models.SymbolEmpty ||
+		gameState.Status != models.GameStatusInProgress ||
+		gameState.CurrentTurn != seat {
+		return
+	}
+
+	if s.broadcaster != nil {
+		s.broadcaster.BroadcastAIThinking(gameState.ID, seat)
+	}
+
+	delay := s.aiMoveDelay
+	if gameState.Mode == models.GameModeCVC {
+		delay = gameState.MovePace
+	}
+
+	gameID := gameState.ID
+	external := gameState.AIStrategy == models.AIStrategyExternal
+	go func() {
+		// External bots take their own time; they are asked without
+		// holding the game lock.
+		var botMove models.Move
+		var botErr error
+		if external {
+			botMove, botErr = s.requestBotMove(gameState, seat)
+		} else {
+			time.Sleep(delay)
+		}
+
+		unlock := s.lockGame(gameID)
+		defer unlock()
+
+		// The caller's copy may still be read by others; the move is played
+		// on a fresh copy from the store.
+		current, err := s.gameStore.Get(gameID)
+		if err != nil {
+			log.Printf("loading game %s for the AI move failed: %v", gameID, err)
+			return
+		}
+		if current.Status != models.GameStatusInProgress || current.CurrentTurn != seat {
+			return
+		}
+
+		if external {
+			playBotMove(current, seat, botMove, botErr)
+		} else {
+			s.playAIReply(context.Background(), current)
+		}
+		s.learnFromGame(current)
+		s.adaptDifficulty(current)
+		current.UpdatedAt = time.Now().UTC()
+
+		if err := s.gameStore.Update(current); err != nil {
+			log.Printf("storing AI move in game %s failed: %v", gameID, err)
+			return
+		}
+		if s.broadcaster != nil {
+			s.broadcaster.BroadcastGameState(gameID, current)
+		}
+		if s.notifier != nil {
+			s.notifier.GameUpdated(current)
+		}
+		if current.Status == models.GameStatusFinished {
+			s.presenceChanged(current)
+		}
+		s.startAIReply(current)
+	}()
+}
+
+// requestBotMove asks the external bot in seat for its move, waiting at most
+// the service's bot move timeout.
+func (s *gameService) requestBotMove(gameState *models.GameState, seat models.Symbol) (models.Move, error) {
+	botID := gameState.PlayerXID
+	if seat == models.SymbolO {
+		botID = gameState.PlayerOID
+	}
+	bot, err := s.playerStore.Get(botID)
+	if err != nil {
+		return models.Move{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.botMoveTimeout)
+	defer cancel()
+	return s.botClient.RequestMove(ctx, bot, gameState, seat)
+}
+
+// playBotMove applies the move an external bot answered with. A bot that
+// failed to answer in time or sent an illegal move forfeits the game.
+func playBotMove(gameState *models.GameState, seat models.Symbol, move models.Move, err error) {
+	if gameState.Status != models.GameStatusInProgress || gameState.CurrentTurn != seat {
+		return
+	}
+	if err == nil {
+		var board models.Board
+		if board, err = game.ApplyVariantMove(gameState, seat, move); err == nil {
+			gameState.Board = board
+			recordMove(gameState, seat, move)
+			updateOutcome(gameState, seat)
+			return
+		}
+	}
+
+	log.Printf("bot in seat %s of game %s forfeits: %v", seat, gameState.ID, err)
+	gameState.Status = models.GameStatusFinished
+	gameState.Winner = string(game.OppositeSymbol(seat))
+	gameState.ForfeitedBy = seat
+}
+
+// presenceChanged tells the presence notifier about both players of a game
+// that just started or finished.
+func (s *gameService) presenceChanged(gameState *models.GameState) {
+	if s.presence == nil {
+		return
+	}
+	for _, playerID := range []string{gameState.PlayerXID, gameState.PlayerOID} {
+		if playerID != "" {
+			s.presence.PresenceChanged(context.Background(), playerID)
+		}
+	}
+}
+
+// lockGame locks the move mutex of a game, which it may share with other
+// games, and returns the function that unlocks it.
+func (s *gameService) lockGame(gameID string) func() {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(gameID))
+	mu := &s.gameLocks[h.Sum32()%gameLockStripes]
+	mu.Lock()
+	return mu.Unlock
+}
+
+// learnFromGame lets MENACE learn from a finished game it played against a
+// human, if the service is configured to do so, and saves the model.
+func (s *gameService) learnFromGame(gameState *models.GameState) {
+	if s.menace == nil || !s.learnFromHumans ||
+		gameState.Mode != models.GameModePVC ||
+		gameState.AIStrategy != models.AIStrategyMenace ||
+		gameState.Status != models.GameStatusFinished {
+		return
+	}
+
+	winner := models.Symbol(gameState.Winner)
+	if gameState.Winner == "DRAW" {
+		winner = models.SymbolEmpty
+	}
+	s.menace.Learn(gameState.History, aiSeat(gameState), winner, true)
+	if err := s.menace.Save(); err != nil {
+		log.Printf("saving MENACE model failed: %v", err)
+	}
+}
+
+// adaptDifficulty moves the human player's adaptive level after a finished
+// adaptive game and stores it with the player.
+func (s *gameService) adaptDifficulty(gameState *models.GameState) {
+	if gameState.Difficulty == nil || gameState.Status != models.GameStatusFinished {
+		return
+	}
+
+	humanSeat := game.OppositeSymbol(aiSeat(gameState))
+	humanID := gameState.PlayerXID
+	if humanSeat == models.SymbolO {
+		humanID = gameState.PlayerOID
+	}
+	player, err := s.playerStore.Get(humanID)
+	if err != nil {
+		log.Printf("updating difficulty of player %s failed: %v", humanID, err)
+		return
+	}
+
+	result := models.MoveResultLoss
+	switch gameState.Winner {
+	case string(humanSeat):
+		result = models.MoveResultWin
+	case "DRAW":
+		result = models.MoveResultDraw
+	}
+	current := *gameState.Difficulty
+	if player.Difficulty != nil {
+		// Another game may have finished since this one started.
+		current = *player.Difficulty
+	}
+	next := ai.AdaptDifficulty(current, result)
+
+	if err := s.playerStore.UpdateDifficulty(humanID, &next); err != nil {
+		log.Printf("updating difficulty of player %s failed: %v", humanID, err)
+	}
+}
+
+// recordMove appends a played move to the game's history.
+func recordMove(gameState *models.GameState, seat models.Symbol, move models.Move) {
+	gameState.History = append(gameState.History, models.MoveRecord{Seat: seat, Move: move})
+}
+
+// updateOutcome checks winner / draw after the player in seat mover has moved and
+// either finishes the game or passes the turn to the other seat.
+func updateOutcome(gameState *models.GameState, mover models.Symbol) {
+	if gameState.Variant == models.GameVariantThreeMensMorris {
+		key := game.PositionKey(gameState.Board, game.OppositeSymbol(mover))
+		gameState.PositionHistory = append(gameState.PositionHistory, key)
+	}
+
+	winner, isDraw := game.Outcome(gameState)
+	if winner != models.SymbolEmpty {
+		gameState.Status = models.GameStatusFinished
+		gameState.Winner = string(winner)
+	} else if isDraw {
+		gameState.Status = models.GameStatusFinished
+		gameState.Winner = "DRAW"
+	} else {
+		// Switch turn.
+		gameState.CurrentTurn = game.OppositeSymbol(mover)
+	}
+}
+
 func (s *gameService) ListGames(ctx context.Context, filter store.GameFilter) ([]*models.GameSummary, error) {
 	games, err := s.gameStore.List(filter)
 	if err != nil {
@@ -254,8 +941,8 @@ func (s *gameService) ListGames(ctx context.Context, filter store.GameFilter) ([
 		}
 
 		// Enrich with creator info if available.
-		if g.PlayerXID != "" {
-			player, err := s.playerStore.Get(g.PlayerXID)
+		if g.CreatedBy != "" {
+			player, err := s.playerStore.Get(g.CreatedBy)
 			if err == nil {
 				summary.CreatedByPlayerID = player.ID
 				summary.CreatedByPlayerName = player.Name
@@ -267,3 +954,107 @@ func (s *gameService) ListGames(ctx context.Context, filter store.GameFilter) ([
 
 	return summaries, nil
 }
+
+// chooseAIMove picks the AI's next move for the game's variant and strategy;
+// the MCTS search stops early when ctx is cancelled. A personality makes the
+// heuristic AI play like a human: it may blunder into a random legal move and,
+// on classic boards, follows its own taste for positions. In fog-of-war
+// games the AI only sees what a human in its seat would see; each attempt into a
+// hidden cell reveals that cell and the AI chooses again.
+func (s *gameService) chooseAIMove(ctx context.Context, gameState *models.GameState, aiSymbol, opponentSymbol models.Symbol) models.Move {
+	switch strategy := aiStrategy(gameState, aiSymbol); {
+	case strategy == models.AIStrategyMCTS:
+		cfg := ai.MCTSConfig{Iterations: gameState.AIIterations, TimeLimit: gameState.AITimeLimit}
+		return ai.ChooseMCTSMove(ctx, gameState, aiSymbol, cfg)
+	case strategy == models.AIStrategyMenace && s.menace != nil:
+		row, col := s.menace.ChooseMove(gameState.Board)
+		return models.Move{Row: row, Col: col}
+	}
+
+	personality := gameState.Personality
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	chooseOnBoard := func(board models.Board) (int, int) {
+		if personality != nil {
+			return ai.ChooseHumanLikeMove(board, aiSymbol, opponentSymbol, gameState.Torus, *personality, rng)
+		}
+		return ai.ChooseMoveWithTorus(board, aiSymbol, opponentSymbol, gameState.Torus)
+	}
+
+	switch gameState.Variant {
+	case models.GameVariantClassic, models.GameVariantFogOfWar:
+	default:
+		if personality != nil && ai.Blunders(*personality, rng) {
+			moves := game.LegalMoves(gameState, aiSymbol)
+			return moves[rng.Intn(len(moves))]
+		}
+	}
+
+	switch gameState.Variant {
+	case models.GameVariantOrderAndChaos:
+		row, col, mark := ai.ChooseOrderChaosMove(gameState.Board, gameState.Roles[aiSymbol], gameState.Torus)
+		return models.Move{Row: row, Col: col, Symbol: mark}
+
+	case models.GameVariantThreeMensMorris:
+		return ai.ChooseMorrisMove(gameState.Board, aiSymbol, opponentSymbol, gameState.Torus)
+
+	case models.GameVariantWild:
+		return ai.ChooseWildMove(gameState.Board, gameState.Torus)
+
+	case models.GameVariantNumerical:
+		return ai.ChooseNumericalMove(gameState.Board, aiSymbol, gameState.Torus)
+
+	case models.GameVariantFogOfWar:
+		for {
+			view := game.VisibleBoard(gameState.Board, aiSymbol, gameState.Revealed[aiSymbol])
+			row, col := chooseOnBoard(view)
+			if !revealHiddenCell(gameState, aiSymbol, row, col) {
+				return models.Move{Row: row, Col: col}
+			}
+		}
+
+	default:
+		row, col := chooseOnBoard(gameState.Board)
+		return models.Move{Row: row, Col: col}
+	}
+}
+
+// thinkLikeHuman pauses for the personality's think time before the AI moves.
+// It returns early when ctx is cancelled.
+func thinkLikeHuman(ctx context.Context, personality *models.Personality) {
+	if personality == nil {
+		return
+	}
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	delay := ai.ThinkDelay(*personality, rng)
+	if delay == 0 {
+		return
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
+// revealHiddenCell records that the player with the given symbol discovered an
+// opponent mark at (row, col). It reports false if the cell is out of bounds,
+// not occupied by the opponent, or already known to the player.
+func revealHiddenCell(gameState *models.GameState, symbol models.Symbol, row, col int) bool {
+	if row < 0 || row >= len(gameState.Board) || col < 0 || col >= len(gameState.Board) {
+		return false
+	}
+	if gameState.Board[row][col] != game.OppositeSymbol(symbol) {
+		return false
+	}
+	if game.IsRevealed(gameState.Revealed[symbol], row, col) {
+		return false
+	}
+
+	if gameState.Revealed == nil {
+		gameState.Revealed = make(map[models.Symbol][][2]int)
+	}
+	gameState.Revealed[symbol] = append(gameState.Revealed[symbol], [2]int{row, col})
+	return true
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"tic-tac-go/internal/models"
+	"tic-tac-go/internal/store"
+)
+
+// inviteAlphabet leaves out characters that are easily confused when a code
+// is read aloud or typed (0/O, 1/I/L).
+const inviteAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+// inviteCodeLength is the number of characters in an invite code.
+const inviteCodeLength = 6
+
+// maxInviteAttempts bounds the search for an unused invite code.
+const maxInviteAttempts = 10
+
+// errNoInviteCode is returned if no unused invite code was found.
+var errNoInviteCode = errors.New("no unused invite code found")
+
+// newInviteCode returns a random invite code that no other game uses.
+func (s *gameService) newInviteCode() (string, error) {
+	for attempt := 0; attempt < maxInviteAttempts; attempt++ {
+		var b strings.Builder
+		for i := 0; i < inviteCodeLength; i++ {
+			n, err := rand.Int(rand.Reader, big.NewInt(int64(len(inviteAlphabet))))
+			if err != nil {
+				return "", err
+			}
+			b.WriteByte(inviteAlphabet[n.Int64()])
+		}
+		code := b.String()
+
+		games, err := s.gameStore.List(store.GameFilter{InviteCode: code})
+		if err != nil {
+			return "", err
+		}
+		if len(games) == 0 {
+			return code, nil
+		}
+	}
+	return "", errNoInviteCode
+}
+
+// normalizeInviteCode accepts codes in lower case and with spaces or dashes.
+func normalizeInviteCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(code)))
+}
+
+// JoinGameByCode lets playerID take the open seat of the private game with
+// the given invite code.
+func (s *gameService) JoinGameByCode(ctx context.Context, code, playerID string) (*models.GameState, error) {
+	// Ensure player exists.
+	if _, err := s.playerStore.Get(playerID); err != nil {
+		return nil, err
+	}
+
+	code = normalizeInviteCode(code)
+	if code == "" {
+		return nil, ErrInvalidInviteCode
+	}
+	games, err := s.gameStore.List(store.GameFilter{InviteCode: code})
+	if err != nil {
+		return nil, err
+	}
+	if len(games) == 0 {
+		return nil, ErrInvalidInviteCode
+	}
+
+	gameState := games[0]
+	if time.Now().UTC().After(gameState.InviteExpiresAt) {
+		return nil, ErrInviteExpired
+	}
+
+	return s.seatSecondPlayer(gameState, playerID)
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package service
+
+import (
+	"context"
+
+	"tic-tac-go/internal/ai"
+	"tic-tac-go/internal/models"
+)
+
+// MaxTrainingGames is the largest number of self-play games one training run may ask for.
+const MaxTrainingGames = 100000
+
+// learningService is a concrete implementation of LearningService.
+type learningService struct {
+	menace *ai.Menace
+}
+
+// NewLearningService constructs a LearningService training the given model.
+func NewLearningService(menace *ai.Menace) LearningService {
+	return &learningService{
+		menace: menace,
+	}
+}
+
+// TrainMenace runs the given number of self-play games against the heuristic
+// AI and saves the model. Training stops early if ctx is cancelled.
+func (s *learningService) TrainMenace(ctx context.Context, games int) (*models.TrainingStats, error) {
+	if games < 1 || games > MaxTrainingGames {
+		return nil, ErrInvalidTraining
+	}
+
+	stats := s.menace.Train(ctx, games)
+	if err := s.menace.Save(); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+// MenaceStats reports the training progress of the model.
+func (s *learningService) MenaceStats(ctx context.Context) (*models.TrainingStats, error) {
+	stats := s.menace.Stats()
+	return &stats, nil
+}
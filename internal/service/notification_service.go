@@ -0,0 +1,202 @@
+// Copyright 2026 Esslingen University of Applied Sciences
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Dennis Grewe
+// Version: 1.0.0
+// Date: 2026-10-18
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"tic-tac-go/internal/game"
+	"tic-tac-go/internal/models"
+	"tic-tac-go/internal/store"
+
+	"github.com/google/uuid"
+)
+
+// notificationService is a concrete implementation of NotificationService.
+type notificationService struct {
+	notificationStore store.NotificationStore
+	playerStore       store.PlayerStore
+	broadcaster       PlayerBroadcaster // Optional: nil if not provided
+}
+
+// NewNotificationService constructs a NotificationService that streams new
+// notifications through broadcaster.
+func NewNotificationService(notificationStore store.NotificationStore, playerStore store.PlayerStore, broadcaster PlayerBroadcaster) NotificationService {
+	return &notificationService{
+		notificationStore: notificationStore,
+		playerStore:       playerStore,
+		broadcaster:       broadcaster,
+	}
+}
+
+// ListNotifications returns the player's notifications, newest first.
+func (s *notificationService) ListNotifications(ctx context.Context, playerID string, unreadOnly bool) ([]*models.Notification, error) {
+	if _, err := s.playerStore.Get(playerID); err != nil {
+		return nil, err
+	}
+	return s.notificationStore.List(store.NotificationFilter{PlayerID: playerID, UnreadOnly: unreadOnly})
+}
+
+// MarkRead marks notifications of the player as read. IDs of other players'
+// notifications are ignored.
+func (s *notificationService) MarkRead(ctx context.Context, playerID string, ids []string) error {
+	unread, err := s.ListNotifications(ctx, playerID, true)
+	if err != nil {
+		return err
+	}
+
+	selected := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		selected[id] = true
+	}
+	for _, n := range unread {
+		if len(ids) > 0 && !selected[n.ID] {
+			continue
+		}
+		if err := s.markRead(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GameUpdated tells the player on turn in a PVP game that it is their move
+// and, once any game is over, tells both players the result. Against the
+// computer the player has just moved and is still at the board, so they get
+// no turn notifications. Bots are not notified.
+func (s *notificationService) GameUpdated(gameState *models.GameState) {
+	switch gameState.Status {
+	case models.GameStatusInProgress:
+		if gameState.Mode != models.GameModePVP {
+			return
+		}
+		// The player who just moved has seen the game.
+		s.clearTurn(gameState, game.OppositeSymbol(gameState.CurrentTurn))
+		playerID, opponentID := seatPlayers(gameState, gameState.CurrentTurn)
+		if s.hasUnreadTurn(playerID, gameState.ID) {
+			return
+		}
+		s.notify(playerID, &models.Notification{
+			Type:    models.NotificationYourTurn,
+			GameID:  gameState.ID,
+			Message: fmt.Sprintf("It is your turn against %s.", s.playerName(opponentID)),
+		})
+
+	case models.GameStatusFinished:
+		for _, seat := range []models.Symbol{models.SymbolX, models.SymbolO} {
+			s.clearTurn(gameState, seat)
+			playerID, opponentID := seatPlayers(gameState, seat)
+			s.notify(playerID, &models.Notification{
+				Type:    models.NotificationGameFinished,
+				GameID:  gameState.ID,
+				Message: resultMessage(gameState, seat, s.playerName(opponentID)),
+			})
+		}
+	}
+}
+
+// Challenged tells the target about a new challenge.
+func (s *notificationService) Challenged(challenge *models.Challenge) {
+	s.notify(challenge.TargetID, &models.Notification{
+		Type:        models.NotificationChallenged,
+		ChallengeID: challenge.ID,
+		Message:     fmt.Sprintf("%s challenged you to a %s game.", s.playerName(challenge.ChallengerID), challenge.Variant),
+	})
+}
+
+// notify stores the notification for a human player and streams it.
+func (s *notificationService) notify(playerID string, n *models.Notification) {
+	player, err := s.playerStore.Get(playerID)
+	if err != nil || player.Bot != nil {
+		return
+	}
+
+	n.ID = uuid.NewString()
+	n.PlayerID = playerID
+	n.CreatedAt = time.Now().UTC()
+	if err := s.notificationStore.Create(n); err != nil {
+		log.Printf("storing notification for player %s failed: %v", playerID, err)
+		return
+	}
+	if s.broadcaster != nil {
+		s.broadcaster.BroadcastNotification(playerID, n)
+	}
+}
+
+// hasUnreadTurn reports whether the player still has an unread YOUR_TURN
+// notification for the game, so moves do not pile up duplicates.
+func (s *notificationService) hasUnreadTurn(playerID, gameID string) bool {
+	unread, err := s.notificationStore.List(store.NotificationFilter{
+		PlayerID: playerID, UnreadOnly: true, GameID: gameID, Type: models.NotificationYourTurn,
+	})
+	return err == nil && len(unread) > 0
+}
+
+// clearTurn marks the YOUR_TURN notifications of the player in seat as read.
+func (s *notificationService) clearTurn(gameState *models.GameState, seat models.Symbol) {
+	playerID, _ := seatPlayers(gameState, seat)
+	unread, err := s.notificationStore.List(store.NotificationFilter{
+		PlayerID: playerID, UnreadOnly: true, GameID: gameState.ID, Type: models.NotificationYourTurn,
+	})
+	if err != nil {
+		return
+	}
+	for _, n := range unread {
+		_ = s.markRead(n)
+	}
+}
+
+// markRead stores a read copy of the notification.
+func (s *notificationService) markRead(n *models.Notification) error {
+	read := *n
+	read.Read = true
+	return s.notificationStore.Update(&read)
+}
+
+// playerName returns the player's name, or "your opponent" if unknown.
+func (s *notificationService) playerName(playerID string) string {
+	player, err := s.playerStore.Get(playerID)
+	if err != nil || player.Name == "" {
+		return "your opponent"
+	}
+	return player.Name
+}
+
+// seatPlayers returns the IDs of the player in seat and of their opponent.
+func seatPlayers(gameState *models.GameState, seat models.Symbol) (string, string) {
+	if seat == models.SymbolO {
+		return gameState.PlayerOID, gameState.PlayerXID
+	}
+	return gameState.PlayerXID, gameState.PlayerOID
+}
+
+// resultMessage describes the outcome of a finished game from seat's view.
+func resultMessage(gameState *models.GameState, seat models.Symbol, opponent string) string {
+	switch {
+	case gameState.Winner == "DRAW":
+		return fmt.Sprintf("Your game against %s ended in a draw.", opponent)
+	case gameState.Winner == string(seat):
+		return fmt.Sprintf("You won against %s.", opponent)
+	default:
+		return fmt.Sprintf("You lost against %s.", opponent)
+	}
+}
@@ -20,7 +20,11 @@ package service
 
 import (
 	"context"
+	"net"
+	"net/url"
+	"sort"
 
+	"tic-tac-go/internal/bot"
 	"tic-tac-go/internal/models"
 	"tic-tac-go/internal/store"
 
@@ -50,6 +54,65 @@ func (s *playerService) CreatePlayer(ctx context.Context, name string) (*models.
 	return player, nil
 }
 
+// RegisterBot creates a player account for an external engine. The server
+// posts the position to callbackURL (http or https) whenever it is the bot's
+// turn, so the URL must point to a public host.
+func (s *playerService) RegisterBot(ctx context.Context, name, callbackURL string) (*models.Player, error) {
+	u, err := url.Parse(callbackURL)
+	if name == "" || err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return nil, ErrInvalidBot
+	}
+	if !publicHost(ctx, u.Hostname()) {
+		return nil, ErrInvalidBot
+	}
+
+	player := &models.Player{
+		ID:   uuid.NewString(),
+		Name: name,
+		Bot:  &models.BotAccount{CallbackURL: callbackURL},
+	}
+	if err := s.playerStore.Create(player); err != nil {
+		return nil, err
+	}
+	return player, nil
+}
+
+// ListBots returns the built-in and registered bots, strongest first.
+func (s *playerService) ListBots(ctx context.Context) ([]*models.Player, error) {
+	players, err := s.playerStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	bots := []*models.Player{}
+	for _, player := range players {
+		if player.Bot != nil {
+			bots = append(bots, player)
+		}
+	}
+	sort.Slice(bots, func(i, j int) bool {
+		if bots[i].Rating != bots[j].Rating {
+			return bots[i].Rating > bots[j].Rating
+		}
+		return bots[i].ID < bots[j].ID
+	})
+	return bots, nil
+}
+
 func (s *playerService) GetPlayer(ctx context.Context, id string) (*models.Player, error) {
 	return s.playerStore.Get(id)
 }
+
+// publicHost reports whether host resolves to public addresses only.
+func publicHost(ctx context.Context, host string) bool {
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return false
+	}
+	for _, addr := range addrs {
+		if !bot.PublicAddress(addr.IP) {
+			return false
+		}
+	}
+	return true
+}
@@ -21,37 +21,256 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
+	"tic-tac-go/internal/ai"
+	"tic-tac-go/internal/game"
 	"tic-tac-go/internal/models"
 	"tic-tac-go/internal/store"
 )
 
 // some service layer error definitions
 var (
-	ErrInvalidGameMode  = errors.New("invalid game mode")
-	ErrInvalidGameState = errors.New("invalid game state")
-	ErrNotParticipant   = errors.New("player is not a participant in this game")
-	ErrNotPlayersTurn   = errors.New("it is not this player's turn")
-	ErrInvalidMove      = errors.New("invalid move")
+	ErrInvalidGameMode    = errors.New("invalid game mode")
+	ErrInvalidGameState   = errors.New("invalid game state")
+	ErrNotParticipant     = errors.New("player is not a participant in this game")
+	ErrNotPlayersTurn     = errors.New("it is not this player's turn")
+	ErrInvalidMove        = errors.New("invalid move")
+	ErrInvalidVariant     = errors.New("invalid game variant")
+	ErrHiddenCellTaken    = errors.New("cell is occupied by a hidden opponent mark")
+	ErrInvalidRole        = errors.New("invalid role")
+	ErrInvalidBoard       = errors.New("invalid board options")
+	ErrInvalidPosition    = errors.New("invalid or unreachable position")
+	ErrHintsDisabled      = errors.New("hints are disabled for this game")
+	ErrHintsUnavailable   = errors.New("hints are not available for this variant")
+	ErrReviewUnavailable  = errors.New("review is only available for classic games")
+	ErrInvalidRecord      = errors.New("game record cannot be replayed")
+	ErrInvalidStrategy    = errors.New("invalid AI strategy or budget")
+	ErrInvalidTraining    = errors.New("invalid number of training games")
+	ErrInvalidPersonality = errors.New("invalid AI personality")
+	ErrInvalidDifficulty  = errors.New("adaptive difficulty requires a heuristic PVC game without a personality")
+	ErrInvalidSeat        = errors.New("invalid seat")
+	ErrInvalidPace        = errors.New("invalid move pace")
+	ErrInvalidBot         = errors.New("invalid bot name or callback URL")
+	ErrInvalidOpponent    = errors.New("opponent is not an available bot")
+	ErrPrivateGame        = errors.New("private games can only be joined with their invite code")
+	ErrInvalidInviteCode  = errors.New("unknown invite code")
+	ErrInviteExpired      = errors.New("invite code has expired")
+	ErrInvalidTimeControl = errors.New("invalid time control")
+	ErrTimeExpired        = errors.New("player has run out of time")
+	ErrInvalidChallenge   = errors.New("players cannot challenge themselves or bots")
+	ErrNotChallengeTarget = errors.New("only the challenged player can answer a challenge")
+	ErrChallengeClosed    = errors.New("challenge is no longer pending")
+	ErrChallengeExpired   = errors.New("challenge has expired")
+	ErrInvalidFriend      = errors.New("players cannot befriend themselves or bots")
+)
+
+// GameOptions holds optional settings chosen when a game is created.
+// The zero value creates a classic game.
+type GameOptions struct {
+	Variant models.GameVariant
+	// Role is the creator's role in asymmetric variants (defaults to ORDER)
+	Role models.Role
+	// BlockedCells lists [row, col] cells that are permanently unavailable
+	BlockedCells [][2]int
+	// RandomBlockedCells is the number of additional cells blocked at random
+	RandomBlockedCells int
+	// Torus makes lines wrap around the edges of the board
+	Torus bool
+	// DisableHints forbids hint requests, e.g. for rated games
+	DisableHints bool
+	// AIStrategy selects the computer opponent's algorithm (defaults to HEURISTIC)
+	AIStrategy models.AIStrategy
+	// OpponentAIStrategy is the strategy of seat O in CVC games, where
+	// AIStrategy plays X (defaults to AIStrategy)
+	OpponentAIStrategy models.AIStrategy
+	// MovePace is the pause before each move in CVC games (defaults to DefaultMovePace)
+	MovePace time.Duration
+	// AIIterations and AITimeLimit bound the MCTS search; zero uses the default
+	AIIterations int
+	AITimeLimit  time.Duration
+	// Personality names a preset that makes the heuristic PVC opponent play like a human
+	Personality string
+	// AdaptiveDifficulty lets the PVC opponent's strength follow the creator's recent results
+	AdaptiveDifficulty bool
+	// OpponentID names a bot account to play against in PVC games instead of
+	// the built-in AI
+	OpponentID string
+	// PlayAs is the creator's seat in PVC games: "X" (default), "O" or PlayAsRandom.
+	// If the creator takes O, the AI opens the game.
+	PlayAs string
+	// Private hides a PVP game from listings; the second player joins with
+	// the game's invite code
+	Private bool
+	// TimeControl sets the players' clocks in PVP games; nil for none
+	TimeControl *models.TimeControl
+}
+
+// PlayAsRandom lets the server pick the creator's seat at random.
+const PlayAsRandom = "RANDOM"
+
+// GameServiceConfig holds optional settings of a GameService.
+// The zero value plays the AI synchronously without the MENACE strategy.
+type GameServiceConfig struct {
+	// Menace enables the MENACE strategy; nil disables it
+	Menace *ai.Menace
+	// LearnFromHumans lets MENACE keep learning from finished PVC games
+	LearnFromHumans bool
+	// AsyncAI lets the AI reply in the background: the caller's move is
+	// stored and broadcast immediately, the AI move follows after AIMoveDelay
+	AsyncAI     bool
+	AIMoveDelay time.Duration
+	// BotClient asks external bots for their moves; nil disables bot opponents
+	BotClient BotClient
+	// BotMoveTimeout is how long a bot may think before it forfeits
+	// (defaults to DefaultBotMoveTimeout)
+	BotMoveTimeout time.Duration
+	// InviteTTL is how long the invite code of a private game can be used
+	// (defaults to DefaultInviteTTL)
+	InviteTTL time.Duration
+	// Notifier records turns and results in the players' inboxes; nil disables it
+	Notifier Notifier
+	// Presence is told when the players of a game start or finish it; nil
+	// disables it
+	Presence PresenceNotifier
+}
+
+// DefaultAIMoveDelay is the pause before an asynchronous AI move.
+const DefaultAIMoveDelay = 500 * time.Millisecond
+
+// DefaultBotMoveTimeout is how long an external bot may take for a move.
+const DefaultBotMoveTimeout = 10 * time.Second
+
+// DefaultInviteTTL is how long the invite code of a private game is valid.
+const DefaultInviteTTL = 24 * time.Hour
+
+// BotClient asks an external bot for its move in a game. The call must give
+// up once ctx is done.
+type BotClient interface {
+	RequestMove(ctx context.Context, bot *models.Player, state *models.GameState, seat models.Symbol) (models.Move, error)
+}
+
+// Upper bounds of the MCTS budget a game may ask for.
+const (
+	MaxAIIterations = 100000
+	MaxAITimeLimit  = 5 * time.Second
+)
+
+// MaxTimeControl is the longest initial thinking time a game may have.
+const MaxTimeControl = time.Hour
+
+// ChallengeOptions holds the game settings a challenger proposes.
+type ChallengeOptions struct {
+	// Mode of the game (defaults to PVP, the only mode between two players)
+	Mode models.GameMode
+	// Variant of the game (defaults to CLASSIC)
+	Variant     models.GameVariant
+	TimeControl *models.TimeControl
+}
+
+// DefaultChallengeTTL is how long a challenge waits for an answer.
+const DefaultChallengeTTL = 5 * time.Minute
+
+// Pace of CVC games: the default and the slowest a game may ask for.
+const (
+	DefaultMovePace = time.Second
+	MaxMovePace     = 10 * time.Second
 )
 
 // GameService defines the high-level use-cases for managing games
 type GameService interface {
 	CreateGame(ctx context.Context, creatorPlayerID string, mode models.GameMode) (*models.GameState, error)
+	CreateGameWithOptions(ctx context.Context, creatorPlayerID string, mode models.GameMode, opts GameOptions) (*models.GameState, error)
 	JoinGame(ctx context.Context, gameID, playerID string) (*models.GameState, error)
+	JoinGameByCode(ctx context.Context, code, playerID string) (*models.GameState, error)
 	GetGame(ctx context.Context, gameID string) (*models.GameState, error)
 	MakeMove(ctx context.Context, gameID, playerID string, row, col int) (*models.GameState, error)
+	PlayMove(ctx context.Context, gameID, playerID string, move models.Move) (*models.GameState, error)
+	GetHint(ctx context.Context, gameID, playerID string) (*models.Hint, error)
+	ExportGame(ctx context.Context, gameID string) (*game.GameRecord, error)
+	ImportGame(ctx context.Context, playerID string, record *game.GameRecord) (*models.GameState, error)
 	ListGames(ctx context.Context, filter store.GameFilter) ([]*models.GameSummary, error)
 }
 
 // PlayerService defines use-cases for managing players
 type PlayerService interface {
 	CreatePlayer(ctx context.Context, name string) (*models.Player, error)
+	RegisterBot(ctx context.Context, name, callbackURL string) (*models.Player, error)
+	ListBots(ctx context.Context) ([]*models.Player, error)
 	GetPlayer(ctx context.Context, id string) (*models.Player, error)
 }
 
+// ChallengeService defines use-cases for challenging a specific player
+type ChallengeService interface {
+	CreateChallenge(ctx context.Context, challengerID, targetID string, opts ChallengeOptions) (*models.Challenge, error)
+	AcceptChallenge(ctx context.Context, challengeID, playerID string) (*models.Challenge, *models.GameState, error)
+	DeclineChallenge(ctx context.Context, challengeID, playerID string) (*models.Challenge, error)
+	ListChallenges(ctx context.Context, playerID string) ([]*models.Challenge, error)
+}
+
+// NotificationService defines use-cases for the players' notification inboxes
+type NotificationService interface {
+	Notifier
+	ListNotifications(ctx context.Context, playerID string, unreadOnly bool) ([]*models.Notification, error)
+	// MarkRead marks the given notifications of the player as read; no IDs marks all
+	MarkRead(ctx context.Context, playerID string, ids []string) error
+}
+
+// Notifier records events for players who may not be watching. Games report
+// every stored move, challenges every new challenge.
+type Notifier interface {
+	GameUpdated(gameState *models.GameState)
+	Challenged(challenge *models.Challenge)
+}
+
+// FriendService defines use-cases for friends and their online presence
+type FriendService interface {
+	AddFriend(ctx context.Context, playerID, friendID string) error
+	RemoveFriend(ctx context.Context, playerID, friendID string) error
+	ListFriends(ctx context.Context, playerID string) ([]*models.Presence, error)
+	PresenceNotifier
+}
+
+// PresenceNotifier is told whenever a player's presence may have changed:
+// connections open and close, games start and finish.
+type PresenceNotifier interface {
+	// PresenceChanged pushes the player's current presence to their friends
+	PresenceChanged(ctx context.Context, playerID string)
+}
+
+// PresenceSource reports which players are connected. Online is true while
+// the player has any open connection; gameIDs lists the games they watch or play.
+type PresenceSource interface {
+	Presence(playerID string) (online bool, gameIDs []string)
+}
+
+// AnalysisService defines use-cases for evaluating arbitrary positions
+type AnalysisService interface {
+	AnalyzePosition(ctx context.Context, board models.Board, toMove models.Symbol) (*models.PositionAnalysis, error)
+	ReviewGame(ctx context.Context, gameID string) (*models.GameReview, error)
+}
+
+// LearningService defines use-cases for training the self-learning AI
+type LearningService interface {
+	TrainMenace(ctx context.Context, games int) (*models.TrainingStats, error)
+	MenaceStats(ctx context.Context) (*models.TrainingStats, error)
+}
+
 // GameStateBroadcaster defines an interface for broadcasting game state updates.
 // This allows the service layer to notify WebSocket clients without directly depending on the WebSocket implementation.
 type GameStateBroadcaster interface {
 	BroadcastGameState(gameID string, state *models.GameState)
+	// BroadcastAIThinking announces that the AI in seat has started to think about its move
+	BroadcastAIThinking(gameID string, seat models.Symbol)
+}
+
+// PlayerBroadcaster defines an interface for pushing events to a player's own
+// WebSocket channel, independent of any game.
+type PlayerBroadcaster interface {
+	// BroadcastChallenge sends the current state of a challenge the player takes part in
+	BroadcastChallenge(playerID string, challenge *models.Challenge)
+	// BroadcastPresence sends the presence of one of the player's friends
+	BroadcastPresence(playerID string, presence *models.Presence)
+	// BroadcastNotification sends a new entry of the player's inbox
+	BroadcastNotification(playerID string, notification *models.Notification)
 }
@@ -20,8 +20,12 @@ package service
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
+	"tic-tac-go/internal/ai"
+	"tic-tac-go/internal/game"
 	"tic-tac-go/internal/models"
 	"tic-tac-go/internal/store"
 )
@@ -84,8 +88,8 @@ func TestGameService_CreateGame_PVPAndPVC(t *testing.T) {
 	if gamePVC.Status != models.GameStatusInProgress {
 		t.Fatalf("expected status IN_PROGRESS, got %q", gamePVC.Status)
 	}
-	if gamePVC.PlayerOID != "AI" {
-		t.Fatalf("expected PlayerOID AI, got %q", gamePVC.PlayerOID)
+	if gamePVC.PlayerOID != "bot-heuristic" {
+		t.Fatalf("expected PlayerOID bot-heuristic, got %q", gamePVC.PlayerOID)
 	}
 }
 
@@ -186,3 +190,1418 @@ func TestGameService_ListGames(t *testing.T) {
 		t.Fatalf("expected CreatedByPlayerID p1, got %q", summaries[0].CreatedByPlayerID)
 	}
 }
+
+func TestGameService_MakeMove_FogOfWarRevealsHiddenCell(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "pX", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "pO", Name: "Bob"})
+
+	svc := NewGameService(gameStore, playerStore)
+
+	gameState, err := svc.CreateGameWithOptions(ctx, "pX", models.GameModePVP, GameOptions{Variant: models.GameVariantFogOfWar})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	_, _ = svc.JoinGame(ctx, gameState.ID, "pO")
+	_, _ = svc.MakeMove(ctx, gameState.ID, "pX", 1, 1)
+
+	// O tries the centre without knowing X is already there.
+	_, err = svc.MakeMove(ctx, gameState.ID, "pO", 1, 1)
+	if err != ErrHiddenCellTaken {
+		t.Fatalf("expected ErrHiddenCellTaken, got %v", err)
+	}
+
+	got, _ := svc.GetGame(ctx, gameState.ID)
+	if got.CurrentTurn != models.SymbolO {
+		t.Fatalf("expected O to keep the turn after a rejected move, got %q", got.CurrentTurn)
+	}
+	if len(got.Revealed[models.SymbolO]) != 1 {
+		t.Fatalf("expected one revealed cell for O, got %v", got.Revealed[models.SymbolO])
+	}
+
+	// A second attempt into the now known cell is a plain invalid move.
+	_, err = svc.MakeMove(ctx, gameState.ID, "pO", 1, 1)
+	if err != ErrInvalidMove {
+		t.Fatalf("expected ErrInvalidMove, got %v", err)
+	}
+}
+
+func TestGameService_OrderAndChaos_PVC(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+
+	svc := NewGameService(gameStore, playerStore)
+
+	gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{
+		Variant: models.GameVariantOrderAndChaos,
+		Role:    models.RoleChaos,
+	})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if len(gameState.Board) != 6 {
+		t.Fatalf("expected a 6x6 board, got %d rows", len(gameState.Board))
+	}
+	if gameState.Roles[models.SymbolX] != models.RoleChaos || gameState.Roles[models.SymbolO] != models.RoleOrder {
+		t.Fatalf("expected creator CHAOS and AI ORDER, got %v", gameState.Roles)
+	}
+
+	// The mark must be chosen explicitly.
+	if _, err := svc.MakeMove(ctx, gameState.ID, "p1", 0, 0); err != ErrInvalidMove {
+		t.Fatalf("expected ErrInvalidMove without a symbol, got %v", err)
+	}
+
+	// The human (seat X) may place an O.
+	updated, err := svc.PlayMove(ctx, gameState.ID, "p1", models.Move{Row: 0, Col: 0, Symbol: models.SymbolO})
+	if err != nil {
+		t.Fatalf("PlayMove error = %v", err)
+	}
+	if updated.Board[0][0] != models.SymbolO {
+		t.Fatalf("expected O at (0,0), got %q", updated.Board[0][0])
+	}
+	if len(game.AvailableMoves(updated.Board)) != 34 {
+		t.Fatalf("expected the AI to reply with one move, got %d free cells", len(game.AvailableMoves(updated.Board)))
+	}
+	if updated.CurrentTurn != models.SymbolX {
+		t.Fatalf("expected turn back to X, got %q", updated.CurrentTurn)
+	}
+}
+
+func TestGameService_ThreeMensMorris_SlidingPhase(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "pX", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "pO", Name: "Bob"})
+
+	svc := NewGameService(gameStore, playerStore)
+
+	gameState, _ := svc.CreateGameWithOptions(ctx, "pX", models.GameModePVP, GameOptions{Variant: models.GameVariantThreeMensMorris})
+	_, _ = svc.JoinGame(ctx, gameState.ID, "pO")
+
+	// Place all six pieces without completing a line.
+	placements := []struct {
+		player   string
+		row, col int
+	}{
+		{"pX", 0, 0}, {"pO", 1, 1}, {"pX", 0, 2}, {"pO", 0, 1}, {"pX", 2, 1}, {"pO", 1, 0},
+	}
+	for _, p := range placements {
+		if _, err := svc.MakeMove(ctx, gameState.ID, p.player, p.row, p.col); err != nil {
+			t.Fatalf("placement %+v error = %v", p, err)
+		}
+	}
+
+	// A fourth placement is no longer allowed.
+	if _, err := svc.MakeMove(ctx, gameState.ID, "pX", 2, 2); err != ErrInvalidMove {
+		t.Fatalf("expected ErrInvalidMove for a fourth piece, got %v", err)
+	}
+
+	updated, err := svc.PlayMove(ctx, gameState.ID, "pX", models.Move{Row: 1, Col: 2, From: &[2]int{0, 2}})
+	if err != nil {
+		t.Fatalf("slide error = %v", err)
+	}
+	if updated.Board[0][2] != models.SymbolEmpty || updated.Board[1][2] != models.SymbolX {
+		t.Fatalf("expected X moved from (0,2) to (1,2), got %v", updated.Board)
+	}
+	if len(updated.PositionHistory) != 7 {
+		t.Fatalf("expected 7 recorded positions, got %d", len(updated.PositionHistory))
+	}
+}
+
+func TestGameService_Numerical_PVP(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "pX", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "pO", Name: "Bob"})
+
+	svc := NewGameService(gameStore, playerStore)
+
+	gameState, _ := svc.CreateGameWithOptions(ctx, "pX", models.GameModePVP, GameOptions{Variant: models.GameVariantNumerical})
+	_, _ = svc.JoinGame(ctx, gameState.ID, "pO")
+
+	// X places odd numbers only.
+	if _, err := svc.PlayMove(ctx, gameState.ID, "pX", models.Move{Row: 0, Col: 0, Number: 2}); err != ErrInvalidMove {
+		t.Fatalf("expected ErrInvalidMove for an even number by X, got %v", err)
+	}
+
+	moves := []struct {
+		player   string
+		row, col int
+		number   int
+	}{
+		{"pX", 0, 0, 5}, {"pO", 2, 2, 2}, {"pX", 0, 1, 1}, {"pO", 2, 1, 4},
+	}
+	for _, m := range moves {
+		if _, err := svc.PlayMove(ctx, gameState.ID, m.player, models.Move{Row: m.row, Col: m.col, Number: m.number}); err != nil {
+			t.Fatalf("move %+v error = %v", m, err)
+		}
+	}
+
+	// A number can only be used once.
+	if _, err := svc.PlayMove(ctx, gameState.ID, "pX", models.Move{Row: 1, Col: 0, Number: 5}); err != ErrInvalidMove {
+		t.Fatalf("expected ErrInvalidMove for a reused number, got %v", err)
+	}
+
+	// 5 + 1 + 9 completes the top row.
+	updated, err := svc.PlayMove(ctx, gameState.ID, "pX", models.Move{Row: 0, Col: 2, Number: 9})
+	if err != nil {
+		t.Fatalf("winning move error = %v", err)
+	}
+	if updated.Status != models.GameStatusFinished || updated.Winner != "X" {
+		t.Fatalf("expected X to win, got status=%q winner=%q", updated.Status, updated.Winner)
+	}
+}
+
+func TestGameService_CreateGame_BlockedCellsAndTorus(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+
+	svc := NewGameService(gameStore, playerStore)
+
+	gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{
+		BlockedCells:       [][2]int{{0, 0}},
+		RandomBlockedCells: 2,
+		Torus:              true,
+	})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if !gameState.Torus {
+		t.Fatalf("expected torus game")
+	}
+	if gameState.Board[0][0] != models.SymbolBlocked {
+		t.Fatalf("expected (0,0) to be blocked, got %q", gameState.Board[0][0])
+	}
+	if got := game.CountSymbol(gameState.Board, models.SymbolBlocked); got != 3 {
+		t.Fatalf("expected 3 blocked cells, got %d", got)
+	}
+
+	if _, err := svc.MakeMove(ctx, gameState.ID, "p1", 0, 0); err != ErrInvalidMove {
+		t.Fatalf("expected ErrInvalidMove on a blocked cell, got %v", err)
+	}
+
+	// Too many blocked cells leave no game to play.
+	_, err = svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{RandomBlockedCells: 4})
+	if err != ErrInvalidBoard {
+		t.Fatalf("expected ErrInvalidBoard, got %v", err)
+	}
+}
+
+func TestGameService_GetHint(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "p2", Name: "Bob"})
+
+	svc := NewGameService(gameStore, playerStore)
+
+	gameState, err := svc.CreateGame(ctx, "p1", models.GameModePVP)
+	if err != nil {
+		t.Fatalf("CreateGame error = %v", err)
+	}
+	if _, err := svc.JoinGame(ctx, gameState.ID, "p2"); err != nil {
+		t.Fatalf("JoinGame error = %v", err)
+	}
+
+	if _, err := svc.GetHint(ctx, gameState.ID, "p2"); err != ErrNotPlayersTurn {
+		t.Fatalf("expected ErrNotPlayersTurn, got %v", err)
+	}
+
+	hint, err := svc.GetHint(ctx, gameState.ID, "p1")
+	if err != nil {
+		t.Fatalf("GetHint error = %v", err)
+	}
+	if hint.Row != 1 || hint.Col != 1 || hint.Reason != models.HintReasonTakeCenter {
+		t.Fatalf("expected to take the center, got %+v", hint)
+	}
+
+	got, _ := svc.GetGame(ctx, gameState.ID)
+	if got.HintsUsed[models.SymbolX] != 1 {
+		t.Fatalf("expected 1 hint used by X, got %d", got.HintsUsed[models.SymbolX])
+	}
+
+	// Rated games switch hints off.
+	rated, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{DisableHints: true})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if _, err := svc.GetHint(ctx, rated.ID, "p1"); err != ErrHintsDisabled {
+		t.Fatalf("expected ErrHintsDisabled, got %v", err)
+	}
+}
+
+func TestAnalysisService_ReviewGame(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "p2", Name: "Bob"})
+
+	svc := NewGameService(gameStore, playerStore)
+	analysisSvc := NewAnalysisService(ai.NewSolver(), gameStore)
+
+	gameState, _ := svc.CreateGame(ctx, "p1", models.GameModePVP)
+	_, _ = svc.JoinGame(ctx, gameState.ID, "p2")
+
+	moves := []struct {
+		player   string
+		row, col int
+	}{
+		{"p1", 1, 1}, {"p2", 0, 1}, {"p1", 0, 0}, {"p2", 2, 2}, {"p1", 2, 0}, {"p2", 1, 0},
+	}
+	for _, m := range moves {
+		if _, err := svc.MakeMove(ctx, gameState.ID, m.player, m.row, m.col); err != nil {
+			t.Fatalf("MakeMove(%d,%d) error = %v", m.row, m.col, err)
+		}
+	}
+
+	if _, err := analysisSvc.ReviewGame(ctx, gameState.ID); err != ErrInvalidGameState {
+		t.Fatalf("expected ErrInvalidGameState for a running game, got %v", err)
+	}
+
+	if _, err := svc.MakeMove(ctx, gameState.ID, "p1", 0, 2); err != nil {
+		t.Fatalf("MakeMove error = %v", err)
+	}
+
+	review, err := analysisSvc.ReviewGame(ctx, gameState.ID)
+	if err != nil {
+		t.Fatalf("ReviewGame error = %v", err)
+	}
+	if len(review.Moves) != 7 {
+		t.Fatalf("expected 7 reviewed moves, got %d", len(review.Moves))
+	}
+	if review.Moves[1].Quality != models.MoveQualityBlunder {
+		t.Fatalf("expected O's second-ply move to be a blunder, got %s", review.Moves[1].Quality)
+	}
+	if review.Accuracy[models.SymbolX] != 100 {
+		t.Fatalf("expected X accuracy 100, got %v", review.Accuracy[models.SymbolX])
+	}
+}
+
+func TestGameService_ExportAndImportGame(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "p2", Name: "Bob"})
+
+	svc := NewGameService(gameStore, playerStore)
+
+	gameState, _ := svc.CreateGame(ctx, "p1", models.GameModePVP)
+	_, _ = svc.JoinGame(ctx, gameState.ID, "p2")
+	_, _ = svc.MakeMove(ctx, gameState.ID, "p1", 1, 1)
+	gameState, _ = svc.MakeMove(ctx, gameState.ID, "p2", 0, 0)
+
+	record, err := svc.ExportGame(ctx, gameState.ID)
+	if err != nil {
+		t.Fatalf("ExportGame error = %v", err)
+	}
+	if record.PlayerX != "Alice" || record.PlayerO != "Bob" || record.Result != game.RecordResultOngoing {
+		t.Fatalf("unexpected record headers: %+v", record)
+	}
+
+	parsed, err := game.ParseRecord(game.FormatRecord(record))
+	if err != nil {
+		t.Fatalf("ParseRecord error = %v", err)
+	}
+	imported, err := svc.ImportGame(ctx, "p2", parsed)
+	if err != nil {
+		t.Fatalf("ImportGame error = %v", err)
+	}
+	if imported.ID == gameState.ID || imported.PlayerXID != "p2" {
+		t.Fatalf("expected a new game owned by the importer, got %+v", imported)
+	}
+	if imported.Status != models.GameStatusWaitingForPlayer || imported.CurrentTurn != models.SymbolX {
+		t.Fatalf("expected imported game waiting with X to move, got %s / %s", imported.Status, imported.CurrentTurn)
+	}
+	if game.BoardKey(imported.Board) != game.BoardKey(gameState.Board) {
+		t.Fatalf("expected board %s, got %s", game.BoardKey(gameState.Board), game.BoardKey(imported.Board))
+	}
+
+	// Joining keeps the replayed turn order.
+	joined, err := svc.JoinGame(ctx, imported.ID, "p1")
+	if err != nil {
+		t.Fatalf("JoinGame error = %v", err)
+	}
+	if joined.CurrentTurn != models.SymbolX || len(joined.History) != 2 {
+		t.Fatalf("unexpected state after join: %s, %d moves", joined.CurrentTurn, len(joined.History))
+	}
+
+	// Illegal moves are rejected.
+	parsed.Moves = append(parsed.Moves, models.MoveRecord{Seat: models.SymbolX, Move: models.Move{Row: 1, Col: 1}})
+	if _, err := svc.ImportGame(ctx, "p2", parsed); err != ErrInvalidRecord {
+		t.Fatalf("expected ErrInvalidRecord, got %v", err)
+	}
+}
+
+func TestGameService_MCTSStrategy(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+
+	svc := NewGameService(gameStore, playerStore)
+
+	gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{
+		AIStrategy:   models.AIStrategyMCTS,
+		AIIterations: 500,
+	})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if gameState.AIStrategy != models.AIStrategyMCTS {
+		t.Fatalf("expected MCTS strategy, got %s", gameState.AIStrategy)
+	}
+
+	updated, err := svc.MakeMove(ctx, gameState.ID, "p1", 1, 1)
+	if err != nil {
+		t.Fatalf("MakeMove error = %v", err)
+	}
+	if len(updated.History) != 2 || updated.CurrentTurn != models.SymbolX {
+		t.Fatalf("expected the AI to reply, got %d moves and turn %s", len(updated.History), updated.CurrentTurn)
+	}
+
+	_, err = svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{
+		Variant:    models.GameVariantFogOfWar,
+		AIStrategy: models.AIStrategyMCTS,
+	})
+	if err != ErrInvalidStrategy {
+		t.Fatalf("expected ErrInvalidStrategy for fog-of-war, got %v", err)
+	}
+	_, err = svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{AIStrategy: "RANDOM"})
+	if err != ErrInvalidStrategy {
+		t.Fatalf("expected ErrInvalidStrategy, got %v", err)
+	}
+}
+
+func TestGameService_MenaceLearnsFromHumans(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+
+	menace := ai.NewMenace()
+	svc := NewGameServiceWithConfig(gameStore, playerStore, nil, GameServiceConfig{Menace: menace, LearnFromHumans: true})
+
+	gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{AIStrategy: models.AIStrategyMenace})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+
+	// Play the first free cell until the game ends.
+	for gameState.Status == models.GameStatusInProgress {
+		cell := game.AvailableMoves(gameState.Board)[0]
+		if gameState, err = svc.MakeMove(ctx, gameState.ID, "p1", cell[0], cell[1]); err != nil {
+			t.Fatalf("MakeMove error = %v", err)
+		}
+	}
+
+	if stats := menace.Stats(); stats.HumanGames != 1 {
+		t.Fatalf("expected MENACE to learn from 1 human game, got %d", stats.HumanGames)
+	}
+
+	// Without a model the strategy is unavailable.
+	plain := NewGameService(gameStore, playerStore)
+	if _, err := plain.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{AIStrategy: models.AIStrategyMenace}); err != ErrInvalidStrategy {
+		t.Fatalf("expected ErrInvalidStrategy, got %v", err)
+	}
+}
+
+func TestLearningService_TrainMenace(t *testing.T) {
+	svc := NewLearningService(ai.NewMenace())
+
+	if _, err := svc.TrainMenace(context.Background(), 0); err != ErrInvalidTraining {
+		t.Fatalf("expected ErrInvalidTraining, got %v", err)
+	}
+	stats, err := svc.TrainMenace(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("TrainMenace error = %v", err)
+	}
+	if stats.GamesTrained != 10 {
+		t.Fatalf("expected 10 training games, got %d", stats.GamesTrained)
+	}
+}
+
+func TestGameService_Personality(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+
+	svc := NewGameService(gameStore, playerStore)
+
+	gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{Personality: "MACHINE"})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if gameState.Personality == nil || gameState.Personality.Name != "MACHINE" {
+		t.Fatalf("expected MACHINE personality, got %+v", gameState.Personality)
+	}
+
+	updated, err := svc.MakeMove(ctx, gameState.ID, "p1", 1, 1)
+	if err != nil {
+		t.Fatalf("MakeMove error = %v", err)
+	}
+	if len(updated.History) != 2 || updated.CurrentTurn != models.SymbolX {
+		t.Fatalf("expected the AI to reply, got %d moves and turn %s", len(updated.History), updated.CurrentTurn)
+	}
+
+	for _, tc := range []struct {
+		mode models.GameMode
+		opts GameOptions
+	}{
+		{models.GameModePVC, GameOptions{Personality: "GRANDMASTER"}},
+		{models.GameModePVP, GameOptions{Personality: "CASUAL"}},
+		{models.GameModePVC, GameOptions{Personality: "CASUAL", AIStrategy: models.AIStrategyMCTS}},
+	} {
+		if _, err := svc.CreateGameWithOptions(ctx, "p1", tc.mode, tc.opts); err != ErrInvalidPersonality {
+			t.Fatalf("%s %+v: expected ErrInvalidPersonality, got %v", tc.mode, tc.opts, err)
+		}
+	}
+}
+
+func TestGameService_AdaptiveDifficulty(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	// Start at the top level, which plays without think time.
+	top := ai.MaxDifficultyLevel()
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice", Difficulty: &models.Difficulty{Level: top}})
+
+	svc := NewGameService(gameStore, playerStore)
+
+	gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{AdaptiveDifficulty: true})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if gameState.Difficulty == nil || gameState.Difficulty.Level != top || gameState.Personality == nil {
+		t.Fatalf("expected level %d with a personality, got %+v / %+v", top, gameState.Difficulty, gameState.Personality)
+	}
+
+	for gameState.Status == models.GameStatusInProgress {
+		cell := game.AvailableMoves(gameState.Board)[0]
+		if gameState, err = svc.MakeMove(ctx, gameState.ID, "p1", cell[0], cell[1]); err != nil {
+			t.Fatalf("MakeMove error = %v", err)
+		}
+	}
+
+	player, _ := playerStore.Get("p1")
+	if player.Difficulty == nil || player.Difficulty.GamesPlayed != 1 {
+		t.Fatalf("expected one adaptive game on the player, got %+v", player.Difficulty)
+	}
+	wantStreak := map[string]int{"X": 1, "O": -1, "DRAW": 0}[gameState.Winner]
+	if player.Difficulty.Streak != wantStreak {
+		t.Fatalf("winner %s: expected streak %d, got %d", gameState.Winner, wantStreak, player.Difficulty.Streak)
+	}
+
+	_, err = svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{AdaptiveDifficulty: true, Personality: "CASUAL"})
+	if err != ErrInvalidDifficulty {
+		t.Fatalf("expected ErrInvalidDifficulty with a personality, got %v", err)
+	}
+	_, err = svc.CreateGameWithOptions(ctx, "p1", models.GameModePVP, GameOptions{AdaptiveDifficulty: true})
+	if err != ErrInvalidDifficulty {
+		t.Fatalf("expected ErrInvalidDifficulty for PVP, got %v", err)
+	}
+}
+
+func TestGameService_PlayAsO_AIOpens(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+
+	svc := NewGameService(gameStore, playerStore)
+
+	gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{PlayAs: "O"})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if gameState.PlayerXID != "bot-heuristic" || gameState.PlayerOID != "p1" {
+		t.Fatalf("expected the heuristic bot as X and p1 as O, got X=%q O=%q", gameState.PlayerXID, gameState.PlayerOID)
+	}
+	if len(gameState.History) != 1 || gameState.History[0].Seat != models.SymbolX || gameState.CurrentTurn != models.SymbolO {
+		t.Fatalf("expected the AI to open, got %+v and turn %s", gameState.History, gameState.CurrentTurn)
+	}
+
+	cell := game.AvailableMoves(gameState.Board)[0]
+	updated, err := svc.MakeMove(ctx, gameState.ID, "p1", cell[0], cell[1])
+	if err != nil {
+		t.Fatalf("MakeMove error = %v", err)
+	}
+	if len(updated.History) != 3 || updated.CurrentTurn != models.SymbolO {
+		t.Fatalf("expected the AI to reply as X, got %d moves and turn %s", len(updated.History), updated.CurrentTurn)
+	}
+
+	summaries, _ := svc.ListGames(ctx, store.GameFilter{})
+	if len(summaries) != 1 || summaries[0].CreatedByPlayerID != "p1" {
+		t.Fatalf("expected p1 as creator, got %+v", summaries)
+	}
+
+	random, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{PlayAs: PlayAsRandom})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions(RANDOM) error = %v", err)
+	}
+	if game.SymbolForPlayer(random, "p1") == models.SymbolEmpty {
+		t.Fatalf("expected p1 to hold a seat, got X=%q O=%q", random.PlayerXID, random.PlayerOID)
+	}
+
+	if _, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVP, GameOptions{PlayAs: "O"}); err != ErrInvalidSeat {
+		t.Fatalf("expected ErrInvalidSeat for PVP, got %v", err)
+	}
+	if _, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{PlayAs: "Z"}); err != ErrInvalidSeat {
+		t.Fatalf("expected ErrInvalidSeat, got %v", err)
+	}
+}
+
+// recordingBroadcaster collects broadcast events as "state", "finished"
+// (the state of a finished game) and "ai_thinking".
+type recordingBroadcaster struct {
+	events chan string
+}
+
+func (b *recordingBroadcaster) BroadcastGameState(gameID string, state *models.GameState) {
+	if state.Status == models.GameStatusFinished {
+		b.events <- "finished"
+		return
+	}
+	b.events <- "state"
+}
+
+func (b *recordingBroadcaster) BroadcastAIThinking(gameID string, seat models.Symbol) {
+	b.events <- "ai_thinking"
+}
+
+func TestGameService_AsyncAIMove(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+
+	broadcaster := &recordingBroadcaster{events: make(chan string, 16)}
+	svc := NewGameServiceWithConfig(gameStore, playerStore, broadcaster, GameServiceConfig{
+		AsyncAI:     true,
+		AIMoveDelay: 10 * time.Millisecond,
+	})
+
+	gameState, err := svc.CreateGame(ctx, "p1", models.GameModePVC)
+	if err != nil {
+		t.Fatalf("CreateGame error = %v", err)
+	}
+	<-broadcaster.events
+
+	updated, err := svc.MakeMove(ctx, gameState.ID, "p1", 1, 1)
+	if err != nil {
+		t.Fatalf("MakeMove error = %v", err)
+	}
+	if len(updated.History) != 1 || updated.CurrentTurn != models.SymbolO {
+		t.Fatalf("expected only the human move before the AI replies, got %d moves", len(updated.History))
+	}
+	if _, err := svc.MakeMove(ctx, gameState.ID, "p1", 0, 0); err != ErrNotPlayersTurn {
+		t.Fatalf("expected ErrNotPlayersTurn while the AI thinks, got %v", err)
+	}
+
+	for _, want := range []string{"state", "ai_thinking", "state"} {
+		select {
+		case got := <-broadcaster.events:
+			if got != want {
+				t.Fatalf("expected %s event, got %s", want, got)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %s event", want)
+		}
+	}
+
+	got, _ := svc.GetGame(ctx, gameState.ID)
+	if len(got.History) != 2 || got.CurrentTurn != models.SymbolX {
+		t.Fatalf("expected the AI move after the delay, got %d moves and turn %s", len(got.History), got.CurrentTurn)
+	}
+}
+
+func TestGameService_CVC(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+
+	broadcaster := &recordingBroadcaster{events: make(chan string, 64)}
+	svc := NewGameServiceWithBroadcaster(gameStore, playerStore, broadcaster)
+
+	gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModeCVC, GameOptions{
+		AIStrategy:         models.AIStrategyHeuristic,
+		OpponentAIStrategy: models.AIStrategyMCTS,
+		AIIterations:       200,
+		MovePace:           time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if gameState.AIStrategies[models.SymbolX] != models.AIStrategyHeuristic ||
+		gameState.AIStrategies[models.SymbolO] != models.AIStrategyMCTS {
+		t.Fatalf("unexpected strategies %v", gameState.AIStrategies)
+	}
+	if _, err := svc.MakeMove(ctx, gameState.ID, "p1", 1, 1); err != ErrNotParticipant {
+		t.Fatalf("expected ErrNotParticipant for the creator, got %v", err)
+	}
+	if _, err := svc.MakeMove(ctx, gameState.ID, gameState.PlayerXID, 1, 1); err != ErrNotParticipant {
+		t.Fatalf("expected ErrNotParticipant for a bot player ID, got %v", err)
+	}
+
+	thinking := 0
+	for done := false; !done; {
+		select {
+		case event := <-broadcaster.events:
+			switch event {
+			case "ai_thinking":
+				thinking++
+			case "finished":
+				done = true
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for the CVC game to finish")
+		}
+	}
+
+	got, _ := svc.GetGame(ctx, gameState.ID)
+	if len(got.History) != thinking || got.Winner == "" {
+		t.Fatalf("expected one move per ai_thinking event and a result, got %d moves, %d events, winner %q",
+			len(got.History), thinking, got.Winner)
+	}
+
+	summaries, _ := svc.ListGames(ctx, store.GameFilter{})
+	if len(summaries) != 1 || summaries[0].CreatedByPlayerID != "p1" {
+		t.Fatalf("expected p1 as creator, got %+v", summaries)
+	}
+
+	if _, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModeCVC, GameOptions{MovePace: time.Minute}); err != ErrInvalidPace {
+		t.Fatalf("expected ErrInvalidPace, got %v", err)
+	}
+	if _, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{OpponentAIStrategy: models.AIStrategyMCTS}); err != ErrInvalidStrategy {
+		t.Fatalf("expected ErrInvalidStrategy outside CVC, got %v", err)
+	}
+}
+
+// fakeBotClient answers every move request with the first free cell, or fails with err.
+type fakeBotClient struct {
+	err error
+}
+
+func (c *fakeBotClient) RequestMove(ctx context.Context, bot *models.Player, state *models.GameState, seat models.Symbol) (models.Move, error) {
+	if c.err != nil {
+		return models.Move{}, c.err
+	}
+	cell := game.AvailableMoves(state.Board)[0]
+	return models.Move{Row: cell[0], Col: cell[1]}, nil
+}
+
+func TestGameService_ExternalBot(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	playerSvc := NewPlayerService(playerStore)
+	for _, callbackURL := range []string{
+		"ftp://example.com",
+		"http://localhost:9999/move",
+		"http://127.0.0.1:9999/move",
+		"http://10.0.0.5/move",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/move",
+	} {
+		if _, err := playerSvc.RegisterBot(ctx, "Engine", callbackURL); err != ErrInvalidBot {
+			t.Fatalf("%s: expected ErrInvalidBot, got %v", callbackURL, err)
+		}
+	}
+	bot, err := playerSvc.RegisterBot(ctx, "Engine", "http://93.184.216.34:9999/move")
+	if err != nil {
+		t.Fatalf("RegisterBot error = %v", err)
+	}
+
+	for _, tc := range []struct {
+		name        string
+		client      *fakeBotClient
+		wantHistory int
+		forfeit     bool
+	}{
+		{"answers", &fakeBotClient{}, 2, false},
+		{"times out", &fakeBotClient{err: context.DeadlineExceeded}, 1, true},
+	} {
+		broadcaster := &recordingBroadcaster{events: make(chan string, 16)}
+		svc := NewGameServiceWithConfig(gameStore, playerStore, broadcaster, GameServiceConfig{BotClient: tc.client})
+
+		gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{OpponentID: bot.ID})
+		if err != nil {
+			t.Fatalf("%s: CreateGameWithOptions error = %v", tc.name, err)
+		}
+		if gameState.PlayerOID != bot.ID || gameState.AIStrategy != models.AIStrategyExternal {
+			t.Fatalf("%s: expected the bot in seat O, got %q / %s", tc.name, gameState.PlayerOID, gameState.AIStrategy)
+		}
+		if _, err := svc.MakeMove(ctx, gameState.ID, "p1", 1, 1); err != nil {
+			t.Fatalf("%s: MakeMove error = %v", tc.name, err)
+		}
+
+		// Human move, ai_thinking, bot move.
+		for i := 0; i < 4; i++ {
+			select {
+			case <-broadcaster.events:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("%s: timed out waiting for the bot move", tc.name)
+			}
+		}
+		got, _ := svc.GetGame(ctx, gameState.ID)
+		if len(got.History) != tc.wantHistory {
+			t.Fatalf("%s: expected %d moves, got %d", tc.name, tc.wantHistory, len(got.History))
+		}
+		if tc.forfeit && (got.ForfeitedBy != models.SymbolO || got.Winner != "X" || got.Status != models.GameStatusFinished) {
+			t.Fatalf("%s: expected the bot to forfeit, got %+v", tc.name, got)
+		}
+		if !tc.forfeit && got.CurrentTurn != models.SymbolX {
+			t.Fatalf("%s: expected the human on turn, got %s", tc.name, got.CurrentTurn)
+		}
+		if _, err := svc.MakeMove(ctx, gameState.ID, bot.ID, 0, 0); err != ErrNotParticipant && err != ErrInvalidGameState {
+			t.Fatalf("%s: expected the bot seat to be closed for REST moves, got %v", tc.name, err)
+		}
+	}
+
+	svc := NewGameServiceWithConfig(gameStore, playerStore, nil, GameServiceConfig{BotClient: &fakeBotClient{}})
+	for _, opts := range []GameOptions{
+		{OpponentID: "p1"},
+		{OpponentID: bot.ID, AIStrategy: models.AIStrategyMCTS},
+		{OpponentID: bot.ID, Variant: models.GameVariantFogOfWar},
+	} {
+		if _, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, opts); err != ErrInvalidOpponent {
+			t.Fatalf("%+v: expected ErrInvalidOpponent, got %v", opts, err)
+		}
+	}
+}
+
+func TestGameService_BuiltInBots(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	if err := EnsureBuiltInBots(playerStore); err != nil {
+		t.Fatalf("EnsureBuiltInBots error = %v", err)
+	}
+	// A second call keeps the existing players.
+	if err := EnsureBuiltInBots(playerStore); err != nil {
+		t.Fatalf("EnsureBuiltInBots second call error = %v", err)
+	}
+
+	bots, err := NewPlayerService(playerStore).ListBots(ctx)
+	if err != nil {
+		t.Fatalf("ListBots error = %v", err)
+	}
+	if len(bots) != len(builtInBots) || bots[0].ID != "bot-mcts" {
+		t.Fatalf("expected %d bots led by bot-mcts, got %d", len(builtInBots), len(bots))
+	}
+
+	svc := NewGameService(gameStore, playerStore)
+	gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{OpponentID: "bot-machine"})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if gameState.PlayerOID != "bot-machine" || gameState.Personality == nil || gameState.Personality.Name != "MACHINE" {
+		t.Fatalf("expected bot-machine with the MACHINE personality, got %q / %+v", gameState.PlayerOID, gameState.Personality)
+	}
+
+	gameState, err = svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{Personality: "NOVICE"})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if gameState.PlayerOID != "bot-novice" {
+		t.Fatalf("expected bot-novice in seat O, got %q", gameState.PlayerOID)
+	}
+
+	if _, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{OpponentID: "bot-novice", Personality: "CASUAL"}); err != ErrInvalidOpponent {
+		t.Fatalf("expected ErrInvalidOpponent, got %v", err)
+	}
+
+	// In a mirror match each seat is held by its own bot.
+	gameState, err = svc.CreateGameWithOptions(ctx, "p1", models.GameModeCVC, GameOptions{MovePace: time.Millisecond})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if gameState.PlayerXID != "bot-heuristic" || gameState.PlayerOID != "bot-heuristic-mirror" {
+		t.Fatalf("expected bot-heuristic vs bot-heuristic-mirror, got %q vs %q", gameState.PlayerXID, gameState.PlayerOID)
+	}
+
+	if _, err := builtInBotID(models.AIStrategyMCTS, "NOVICE", ""); err != ErrInvalidStrategy {
+		t.Fatalf("expected ErrInvalidStrategy for an unknown bot, got %v", err)
+	}
+}
+
+func TestGameService_PrivateGame(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "p2", Name: "Bob"})
+
+	svc := NewGameService(gameStore, playerStore)
+
+	if _, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{Private: true}); err != ErrInvalidGameMode {
+		t.Fatalf("expected ErrInvalidGameMode for a private PVC game, got %v", err)
+	}
+
+	gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVP, GameOptions{Private: true})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if len(gameState.InviteCode) != inviteCodeLength || !gameState.InviteExpiresAt.After(gameState.CreatedAt) {
+		t.Fatalf("expected an invite code with expiry, got %q / %v", gameState.InviteCode, gameState.InviteExpiresAt)
+	}
+
+	summaries, err := svc.ListGames(ctx, store.GameFilter{})
+	if err != nil {
+		t.Fatalf("ListGames error = %v", err)
+	}
+	if len(summaries) != 0 {
+		t.Fatalf("expected the private game to be unlisted, got %d games", len(summaries))
+	}
+
+	if _, err := svc.JoinGame(ctx, gameState.ID, "p2"); err != ErrPrivateGame {
+		t.Fatalf("expected ErrPrivateGame, got %v", err)
+	}
+	if _, err := svc.JoinGameByCode(ctx, "ZZZZZZ0", "p2"); err != ErrInvalidInviteCode {
+		t.Fatalf("expected ErrInvalidInviteCode, got %v", err)
+	}
+
+	// Codes are accepted in lower case and with a dash.
+	code := strings.ToLower(gameState.InviteCode[:3] + "-" + gameState.InviteCode[3:])
+	joined, err := svc.JoinGameByCode(ctx, code, "p2")
+	if err != nil {
+		t.Fatalf("JoinGameByCode error = %v", err)
+	}
+	if joined.PlayerOID != "p2" || joined.Status != models.GameStatusInProgress {
+		t.Fatalf("expected p2 in seat O of a running game, got %q / %s", joined.PlayerOID, joined.Status)
+	}
+	if _, err := svc.JoinGameByCode(ctx, gameState.InviteCode, "p2"); err != ErrInvalidGameState {
+		t.Fatalf("expected ErrInvalidGameState for a used code, got %v", err)
+	}
+
+	expiring := NewGameServiceWithConfig(gameStore, playerStore, nil, GameServiceConfig{InviteTTL: time.Nanosecond})
+	gameState, err = expiring.CreateGameWithOptions(ctx, "p1", models.GameModePVP, GameOptions{Private: true})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	time.Sleep(time.Millisecond)
+	if _, err := expiring.JoinGameByCode(ctx, gameState.InviteCode, "p2"); err != ErrInviteExpired {
+		t.Fatalf("expected ErrInviteExpired, got %v", err)
+	}
+}
+
+type recordingPlayerBroadcaster struct {
+	events chan string
+}
+
+func (b *recordingPlayerBroadcaster) BroadcastChallenge(playerID string, challenge *models.Challenge) {
+	b.events <- playerID + ":" + string(challenge.Status)
+}
+
+func (b *recordingPlayerBroadcaster) BroadcastNotification(playerID string, notification *models.Notification) {
+	b.events <- playerID + ":" + string(notification.Type)
+}
+
+func (b *recordingPlayerBroadcaster) BroadcastPresence(playerID string, presence *models.Presence) {
+	b.events <- playerID + ":" + presence.PlayerID + "=" + string(presence.Status)
+}
+
+func TestChallengeService(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "p2", Name: "Bob"})
+	_ = EnsureBuiltInBots(playerStore)
+
+	gameSvc := NewGameService(gameStore, playerStore)
+	broadcaster := &recordingPlayerBroadcaster{events: make(chan string, 16)}
+	svc := NewChallengeService(store.NewMemoryChallengeStore(), playerStore, gameSvc, broadcaster)
+
+	for _, tc := range []struct {
+		target string
+		opts   ChallengeOptions
+		want   error
+	}{
+		{"p1", ChallengeOptions{}, ErrInvalidChallenge},
+		{"bot-heuristic", ChallengeOptions{}, ErrInvalidChallenge},
+		{"p2", ChallengeOptions{Mode: models.GameModePVC}, ErrInvalidGameMode},
+		{"p2", ChallengeOptions{Variant: "CHESS"}, ErrInvalidVariant},
+		{"p2", ChallengeOptions{TimeControl: &models.TimeControl{Initial: 0}}, ErrInvalidTimeControl},
+	} {
+		if _, err := svc.CreateChallenge(ctx, "p1", tc.target, tc.opts); err != tc.want {
+			t.Fatalf("%s %+v: expected %v, got %v", tc.target, tc.opts, tc.want, err)
+		}
+	}
+
+	tc := &models.TimeControl{Initial: 5 * time.Minute, Increment: 2 * time.Second}
+	challenge, err := svc.CreateChallenge(ctx, "p1", "p2", ChallengeOptions{Variant: models.GameVariantWild, TimeControl: tc})
+	if err != nil {
+		t.Fatalf("CreateChallenge error = %v", err)
+	}
+	if got := []string{<-broadcaster.events, <-broadcaster.events}; got[1] != "p2:PENDING" {
+		t.Fatalf("expected the target to be told about the challenge, got %v", got)
+	}
+
+	pending, err := svc.ListChallenges(ctx, "p2")
+	if err != nil || len(pending) != 1 {
+		t.Fatalf("expected one pending challenge, got %d (%v)", len(pending), err)
+	}
+
+	if _, _, err := svc.AcceptChallenge(ctx, challenge.ID, "p1"); err != ErrNotChallengeTarget {
+		t.Fatalf("expected ErrNotChallengeTarget, got %v", err)
+	}
+	accepted, gameState, err := svc.AcceptChallenge(ctx, challenge.ID, "p2")
+	if err != nil {
+		t.Fatalf("AcceptChallenge error = %v", err)
+	}
+	if accepted.Status != models.ChallengeStatusAccepted || accepted.GameID != gameState.ID {
+		t.Fatalf("expected an accepted challenge pointing at the game, got %+v", accepted)
+	}
+	if gameState.PlayerXID != "p1" || gameState.PlayerOID != "p2" || gameState.Status != models.GameStatusInProgress ||
+		gameState.Variant != models.GameVariantWild || gameState.TimeControl == nil || *gameState.TimeControl != *tc || !gameState.Private {
+		t.Fatalf("expected a running private WILD game p1 vs p2 with the clock, got %+v", gameState)
+	}
+	if _, err := svc.DeclineChallenge(ctx, challenge.ID, "p2"); err != ErrChallengeClosed {
+		t.Fatalf("expected ErrChallengeClosed, got %v", err)
+	}
+
+	// Unanswered challenges expire and both players are told.
+	expiring := NewChallengeServiceWithNotifier(store.NewMemoryChallengeStore(), playerStore, gameSvc, broadcaster, nil, 10*time.Millisecond)
+	for len(broadcaster.events) > 0 {
+		<-broadcaster.events
+	}
+	challenge, err = expiring.CreateChallenge(ctx, "p1", "p2", ChallengeOptions{})
+	if err != nil {
+		t.Fatalf("CreateChallenge error = %v", err)
+	}
+	<-broadcaster.events
+	<-broadcaster.events
+	for i := 0; i < 2; i++ {
+		select {
+		case event := <-broadcaster.events:
+			if event != "p1:EXPIRED" && event != "p2:EXPIRED" {
+				t.Fatalf("expected an expiry event, got %q", event)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the challenge to expire")
+		}
+	}
+	if _, _, err := expiring.AcceptChallenge(ctx, challenge.ID, "p2"); err != ErrChallengeClosed {
+		t.Fatalf("expected ErrChallengeClosed after expiry, got %v", err)
+	}
+}
+
+// fakePresence reports the connections of players by ID.
+type fakePresence map[string][]string
+
+func (p fakePresence) Presence(playerID string) (bool, []string) {
+	gameIDs, online := p[playerID]
+	return online, gameIDs
+}
+
+func TestFriendService(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "p2", Name: "Bob"})
+	_ = playerStore.Create(&models.Player{ID: "p3", Name: "Carol"})
+	_ = playerStore.Create(&models.Player{ID: "p4", Name: "Dave"})
+	_ = EnsureBuiltInBots(playerStore)
+
+	gameSvc := NewGameService(gameStore, playerStore)
+	running, _ := gameSvc.CreateGame(ctx, "p2", models.GameModePVP)
+	_, _ = gameSvc.JoinGame(ctx, running.ID, "p4")
+	watched, _ := gameSvc.CreateGame(ctx, "p4", models.GameModePVP)
+
+	presence := fakePresence{"p2": {running.ID}, "p3": {watched.ID}}
+	broadcaster := &recordingPlayerBroadcaster{events: make(chan string, 16)}
+	svc := NewFriendService(playerStore, gameStore, presence, broadcaster)
+
+	for _, id := range []string{"p2", "p3", "p4"} {
+		if err := svc.AddFriend(ctx, "p1", id); err != nil {
+			t.Fatalf("AddFriend(%s) error = %v", id, err)
+		}
+	}
+	// Adding twice is a no-op.
+	_ = svc.AddFriend(ctx, "p2", "p1")
+	for _, id := range []string{"p1", "bot-heuristic"} {
+		if err := svc.AddFriend(ctx, "p1", id); err != ErrInvalidFriend {
+			t.Fatalf("AddFriend(%s): expected ErrInvalidFriend, got %v", id, err)
+		}
+	}
+
+	friends, err := svc.ListFriends(ctx, "p1")
+	if err != nil {
+		t.Fatalf("ListFriends error = %v", err)
+	}
+	var got []string
+	for _, f := range friends {
+		got = append(got, f.PlayerID+"="+string(f.Status))
+	}
+	if want := "p2=IN_GAME p3=ONLINE p4=OFFLINE"; strings.Join(got, " ") != want {
+		t.Fatalf("expected %q, got %q", want, strings.Join(got, " "))
+	}
+	if friends[0].GameID != running.ID {
+		t.Fatalf("expected p2 in game %s, got %q", running.ID, friends[0].GameID)
+	}
+
+	// Friendship is mutual, so p2's presence reaches p1.
+	svc.PresenceChanged(ctx, "p2")
+	if event := <-broadcaster.events; event != "p1:p2=IN_GAME" {
+		t.Fatalf("expected p1 to be told about p2, got %q", event)
+	}
+
+	if err := svc.RemoveFriend(ctx, "p2", "p1"); err != nil {
+		t.Fatalf("RemoveFriend error = %v", err)
+	}
+	friends, _ = svc.ListFriends(ctx, "p1")
+	if len(friends) != 2 {
+		t.Fatalf("expected 2 friends after removal, got %d", len(friends))
+	}
+	if friends, _ := svc.ListFriends(ctx, "p2"); len(friends) != 0 {
+		t.Fatalf("expected p2 to have no friends, got %d", len(friends))
+	}
+}
+
+func TestNotificationService(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "p2", Name: "Bob"})
+
+	broadcaster := &recordingPlayerBroadcaster{events: make(chan string, 32)}
+	notificationSvc := NewNotificationService(store.NewMemoryNotificationStore(), playerStore, broadcaster)
+	gameSvc := NewGameServiceWithConfig(gameStore, playerStore, nil, GameServiceConfig{Notifier: notificationSvc})
+	challengeSvc := NewChallengeServiceWithNotifier(store.NewMemoryChallengeStore(), playerStore, gameSvc, nil, notificationSvc, time.Minute)
+
+	unread := func(playerID string) []*models.Notification {
+		t.Helper()
+		notifications, err := notificationSvc.ListNotifications(ctx, playerID, true)
+		if err != nil {
+			t.Fatalf("ListNotifications error = %v", err)
+		}
+		return notifications
+	}
+
+	challenge, err := challengeSvc.CreateChallenge(ctx, "p1", "p2", ChallengeOptions{})
+	if err != nil {
+		t.Fatalf("CreateChallenge error = %v", err)
+	}
+	if n := unread("p2"); len(n) != 1 || n[0].Type != models.NotificationChallenged || n[0].ChallengeID != challenge.ID {
+		t.Fatalf("expected a CHALLENGED notification for p2, got %+v", n)
+	}
+	if event := <-broadcaster.events; event != "p2:CHALLENGED" {
+		t.Fatalf("expected the notification to be streamed to p2, got %q", event)
+	}
+	if err := notificationSvc.MarkRead(ctx, "p2", nil); err != nil {
+		t.Fatalf("MarkRead error = %v", err)
+	}
+
+	// Accepting starts the game with X (p1) on turn.
+	_, gameState, err := challengeSvc.AcceptChallenge(ctx, challenge.ID, "p2")
+	if err != nil {
+		t.Fatalf("AcceptChallenge error = %v", err)
+	}
+	if n := unread("p1"); len(n) != 1 || n[0].Type != models.NotificationYourTurn {
+		t.Fatalf("expected a YOUR_TURN notification for p1, got %+v", n)
+	}
+
+	// Moving clears the mover's turn notification and tells the opponent.
+	for _, m := range []struct {
+		player   string
+		row, col int
+	}{{"p1", 0, 0}, {"p2", 1, 0}, {"p1", 0, 1}, {"p2", 1, 1}} {
+		if _, err := gameSvc.MakeMove(ctx, gameState.ID, m.player, m.row, m.col); err != nil {
+			t.Fatalf("MakeMove error = %v", err)
+		}
+	}
+	if n := unread("p1"); len(n) != 1 || n[0].Type != models.NotificationYourTurn {
+		t.Fatalf("expected one YOUR_TURN notification for p1, got %+v", n)
+	}
+	if n := unread("p2"); len(n) != 0 {
+		t.Fatalf("expected no unread notifications for p2 after moving, got %+v", n)
+	}
+
+	if _, err := gameSvc.MakeMove(ctx, gameState.ID, "p1", 0, 2); err != nil {
+		t.Fatalf("MakeMove error = %v", err)
+	}
+	for playerID, want := range map[string]string{"p1": "You won against Bob.", "p2": "You lost against Alice."} {
+		n := unread(playerID)
+		if len(n) != 1 || n[0].Type != models.NotificationGameFinished || n[0].Message != want {
+			t.Fatalf("expected %q for %s, got %+v", want, playerID, n)
+		}
+	}
+
+	if err := notificationSvc.MarkRead(ctx, "p2", []string{unread("p2")[0].ID}); err != nil {
+		t.Fatalf("MarkRead error = %v", err)
+	}
+	if n := unread("p2"); len(n) != 0 {
+		t.Fatalf("expected p2's inbox to be read, got %+v", n)
+	}
+}
+
+func TestNotificationService_NoTurnsAgainstComputer(t *testing.T) {
+	ctx := context.Background()
+	playerStore := store.NewMemoryPlayerStore()
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	_ = EnsureBuiltInBots(playerStore)
+
+	notificationSvc := NewNotificationService(store.NewMemoryNotificationStore(), playerStore, nil)
+	svc := NewGameServiceWithConfig(store.NewMemoryGameStore(), playerStore, nil, GameServiceConfig{Notifier: notificationSvc})
+
+	gameState, err := svc.CreateGame(ctx, "p1", models.GameModePVC)
+	if err != nil {
+		t.Fatalf("CreateGame error = %v", err)
+	}
+	for gameState.Status == models.GameStatusInProgress {
+		moves := game.AvailableMoves(gameState.Board)
+		gameState, err = svc.MakeMove(ctx, gameState.ID, "p1", moves[0][0], moves[0][1])
+		if err != nil {
+			t.Fatalf("MakeMove error = %v", err)
+		}
+	}
+
+	notifications, err := notificationSvc.ListNotifications(ctx, "p1", false)
+	if err != nil {
+		t.Fatalf("ListNotifications error = %v", err)
+	}
+	if len(notifications) != 1 || notifications[0].Type != models.NotificationGameFinished {
+		t.Fatalf("expected only the result in p1's inbox, got %+v", notifications)
+	}
+}
+
+func TestGameService_TimeControl(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "p2", Name: "Bob"})
+	svc := NewGameService(gameStore, playerStore)
+
+	tc := &models.TimeControl{Initial: time.Minute, Increment: 5 * time.Second}
+	if _, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVC, GameOptions{TimeControl: tc}); err != ErrInvalidTimeControl {
+		t.Fatalf("expected ErrInvalidTimeControl for a PVC game, got %v", err)
+	}
+
+	gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVP, GameOptions{TimeControl: tc})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if gameState.Clocks != nil {
+		t.Fatalf("expected the clocks to wait for the second player, got %v", gameState.Clocks)
+	}
+	gameState, err = svc.JoinGame(ctx, gameState.ID, "p2")
+	if err != nil {
+		t.Fatalf("JoinGame error = %v", err)
+	}
+	if gameState.Clocks[models.SymbolX] != time.Minute || gameState.Clocks[models.SymbolO] != time.Minute {
+		t.Fatalf("expected both clocks at one minute, got %v", gameState.Clocks)
+	}
+
+	// X moves in time and gains the increment.
+	gameState, err = svc.MakeMove(ctx, gameState.ID, "p1", 0, 0)
+	if err != nil {
+		t.Fatalf("MakeMove error = %v", err)
+	}
+	if left := gameState.Clocks[models.SymbolX]; left <= time.Minute || left > time.Minute+5*time.Second {
+		t.Fatalf("expected X's clock to include the increment, got %v", left)
+	}
+
+	// O lets the clock run out and loses on time.
+	gameState.TurnStartedAt = gameState.TurnStartedAt.Add(-2 * time.Minute)
+	if err := gameStore.Update(gameState); err != nil {
+		t.Fatalf("Update error = %v", err)
+	}
+	if _, err := svc.MakeMove(ctx, gameState.ID, "p2", 1, 1); err != ErrTimeExpired {
+		t.Fatalf("expected ErrTimeExpired, got %v", err)
+	}
+	gameState, _ = svc.GetGame(ctx, gameState.ID)
+	if gameState.Status != models.GameStatusFinished || gameState.Winner != string(models.SymbolX) ||
+		gameState.ForfeitedBy != models.SymbolO || gameState.Clocks[models.SymbolO] != 0 {
+		t.Fatalf("expected O to lose on time, got %+v", gameState)
+	}
+}
+
+// recordingPresence records the players whose presence was reported.
+type recordingPresence struct {
+	players []string
+}
+
+func (p *recordingPresence) PresenceChanged(ctx context.Context, playerID string) {
+	p.players = append(p.players, playerID)
+}
+
+func TestGameService_PresenceOnStartAndFinish(t *testing.T) {
+	ctx := context.Background()
+	playerStore := store.NewMemoryPlayerStore()
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "p2", Name: "Bob"})
+
+	presence := &recordingPresence{}
+	svc := NewGameServiceWithConfig(store.NewMemoryGameStore(), playerStore, nil, GameServiceConfig{Presence: presence})
+
+	gameState, err := svc.CreateGame(ctx, "p1", models.GameModePVP)
+	if err != nil {
+		t.Fatalf("CreateGame error = %v", err)
+	}
+	if len(presence.players) != 0 {
+		t.Fatalf("expected no presence change for a waiting game, got %v", presence.players)
+	}
+	if _, err := svc.JoinGame(ctx, gameState.ID, "p2"); err != nil {
+		t.Fatalf("JoinGame error = %v", err)
+	}
+	if got := strings.Join(presence.players, ","); got != "p1,p2" {
+		t.Fatalf("expected both players when the game starts, got %s", got)
+	}
+
+	presence.players = nil
+	for _, m := range []struct {
+		player   string
+		row, col int
+	}{{"p1", 0, 0}, {"p2", 1, 0}, {"p1", 0, 1}, {"p2", 1, 1}} {
+		if _, err := svc.MakeMove(ctx, gameState.ID, m.player, m.row, m.col); err != nil {
+			t.Fatalf("MakeMove error = %v", err)
+		}
+	}
+	if len(presence.players) != 0 {
+		t.Fatalf("expected no presence change during the game, got %v", presence.players)
+	}
+	if _, err := svc.MakeMove(ctx, gameState.ID, "p1", 0, 2); err != nil {
+		t.Fatalf("MakeMove error = %v", err)
+	}
+	if got := strings.Join(presence.players, ","); got != "p1,p2" {
+		t.Fatalf("expected both players when the game finishes, got %s", got)
+	}
+}
+
+func TestGameService_TimeControlFlagFall(t *testing.T) {
+	ctx := context.Background()
+	playerStore := store.NewMemoryPlayerStore()
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "p2", Name: "Bob"})
+
+	broadcaster := &recordingBroadcaster{events: make(chan string, 16)}
+	svc := NewGameServiceWithBroadcaster(store.NewMemoryGameStore(), playerStore, broadcaster)
+
+	gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVP, GameOptions{
+		TimeControl: &models.TimeControl{Initial: 30 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if _, err := svc.JoinGame(ctx, gameState.ID, "p2"); err != nil {
+		t.Fatalf("JoinGame error = %v", err)
+	}
+
+	// Nobody moves: X's flag falls and the game ends without a request.
+	for done := false; !done; {
+		select {
+		case event := <-broadcaster.events:
+			done = event == "finished"
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for X to lose on time")
+		}
+	}
+
+	got, _ := svc.GetGame(ctx, gameState.ID)
+	if got.Status != models.GameStatusFinished || got.Winner != string(models.SymbolO) ||
+		got.ForfeitedBy != models.SymbolX || got.Clocks[models.SymbolX] != 0 {
+		t.Fatalf("expected X to lose on time, got %+v", got)
+	}
+	if _, err := svc.MakeMove(ctx, gameState.ID, "p1", 0, 0); err != ErrInvalidGameState {
+		t.Fatalf("expected ErrInvalidGameState after the flag fell, got %v", err)
+	}
+}
+
+func TestGameService_ExportAndImportForfeitedGame(t *testing.T) {
+	ctx := context.Background()
+	gameStore := store.NewMemoryGameStore()
+	playerStore := store.NewMemoryPlayerStore()
+	_ = playerStore.Create(&models.Player{ID: "p1", Name: "Alice"})
+	_ = playerStore.Create(&models.Player{ID: "p2", Name: "Bob"})
+	svc := NewGameService(gameStore, playerStore)
+
+	gameState, err := svc.CreateGameWithOptions(ctx, "p1", models.GameModePVP, GameOptions{
+		TimeControl: &models.TimeControl{Initial: time.Minute},
+	})
+	if err != nil {
+		t.Fatalf("CreateGameWithOptions error = %v", err)
+	}
+	if _, err := svc.JoinGame(ctx, gameState.ID, "p2"); err != nil {
+		t.Fatalf("JoinGame error = %v", err)
+	}
+	gameState, err = svc.MakeMove(ctx, gameState.ID, "p1", 1, 1)
+	if err != nil {
+		t.Fatalf("MakeMove error = %v", err)
+	}
+	// O runs out of time.
+	gameState.TurnStartedAt = gameState.TurnStartedAt.Add(-2 * time.Minute)
+	_ = gameStore.Update(gameState)
+	if _, err := svc.MakeMove(ctx, gameState.ID, "p2", 0, 0); err != ErrTimeExpired {
+		t.Fatalf("expected ErrTimeExpired, got %v", err)
+	}
+
+	record, err := svc.ExportGame(ctx, gameState.ID)
+	if err != nil {
+		t.Fatalf("ExportGame error = %v", err)
+	}
+	text := game.FormatRecord(record)
+	if !strings.Contains(text, `[ForfeitedBy "O"]`) {
+		t.Fatalf("expected a ForfeitedBy header, got\n%s", text)
+	}
+	parsed, err := game.ParseRecord(text)
+	if err != nil {
+		t.Fatalf("ParseRecord error = %v", err)
+	}
+	imported, err := svc.ImportGame(ctx, "p2", parsed)
+	if err != nil {
+		t.Fatalf("ImportGame error = %v", err)
+	}
+	if imported.Status != models.GameStatusFinished || imported.Winner != string(models.SymbolX) ||
+		imported.ForfeitedBy != models.SymbolO || len(imported.History) != 1 {
+		t.Fatalf("expected the forfeited game to be restored, got %+v", imported)
+	}
+
+	// A forfeit cannot be added to a game that already ended on the board.
+	parsed.Moves = []models.MoveRecord{
+		{Seat: models.SymbolX, Move: models.Move{Row: 0, Col: 0}}, {Seat: models.SymbolO, Move: models.Move{Row: 1, Col: 0}},
+		{Seat: models.SymbolX, Move: models.Move{Row: 0, Col: 1}}, {Seat: models.SymbolO, Move: models.Move{Row: 1, Col: 1}},
+		{Seat: models.SymbolX, Move: models.Move{Row: 0, Col: 2}},
+	}
+	if _, err := svc.ImportGame(ctx, "p2", parsed); err != ErrInvalidRecord {
+		t.Fatalf("expected ErrInvalidRecord, got %v", err)
+	}
+}
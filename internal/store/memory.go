@@ -19,8 +19,10 @@
 package store
 
 import (
+	"sort"
 	"sync"
 	"tic-tac-go/internal/models"
+	"time"
 )
 
 // MemoryPlayerStore is an in-memory implementation of PlayerStore.
@@ -46,6 +48,45 @@ func (s *MemoryPlayerStore) Create(player *models.Player) error {
 	return nil
 }
 
+// Update replaces a stored player
+func (s *MemoryPlayerStore) Update(player *models.Player) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Only update if it already exists.
+	if _, ok := s.players[player.ID]; !ok {
+		return ErrPlayerNotFound
+	}
+	s.players[player.ID] = player
+	return nil
+}
+
+// UpdateFriends replaces the friend list of a stored player
+func (s *MemoryPlayerStore) UpdateFriends(id string, friends []string) error {
+	return s.updateField(id, func(p *models.Player) { p.Friends = friends })
+}
+
+// UpdateDifficulty replaces the adaptive difficulty of a stored player
+func (s *MemoryPlayerStore) UpdateDifficulty(id string, difficulty *models.Difficulty) error {
+	return s.updateField(id, func(p *models.Player) { p.Difficulty = difficulty })
+}
+
+// updateField stores a copy of the player changed by set. Players handed out
+// by Get are never modified in place.
+func (s *MemoryPlayerStore) updateField(id string, set func(p *models.Player)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	player, ok := s.players[id]
+	if !ok {
+		return ErrPlayerNotFound
+	}
+	updated := *player
+	set(&updated)
+	s.players[id] = &updated
+	return nil
+}
+
 // Lookup of players within the list using its id
 func (s *MemoryPlayerStore) Get(id string) (*models.Player, error) {
 	s.mu.RLock() // read lock to the player store
@@ -59,8 +100,21 @@ func (s *MemoryPlayerStore) Get(id string) (*models.Player, error) {
 	return player, nil
 }
 
+// List returns all players in no particular order
+func (s *MemoryPlayerStore) List() ([]*models.Player, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	players := make([]*models.Player, 0, len(s.players))
+	for _, player := range s.players {
+		players = append(players, player)
+	}
+	return players, nil
+}
+
 // MemoryGameStore is an in-memory implementation of GameStore.
-// It is safe for concurrent use.
+// It is safe for concurrent use: games are copied on the way in and out, so
+// callers never share a *models.GameState with each other or with the store.
 type MemoryGameStore struct {
 	mu    sync.RWMutex
 	games map[string]*models.GameState
@@ -77,7 +131,7 @@ func (s *MemoryGameStore) Create(game *models.GameState) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.games[game.ID] = game
+	s.games[game.ID] = cloneGame(game)
 	return nil
 }
 
@@ -89,7 +143,7 @@ func (s *MemoryGameStore) Update(game *models.GameState) error {
 	if _, ok := s.games[game.ID]; !ok {
 		return ErrGameNotFound
 	}
-	s.games[game.ID] = game
+	s.games[game.ID] = cloneGame(game)
 	return nil
 }
 
@@ -101,7 +155,7 @@ func (s *MemoryGameStore) Get(id string) (*models.GameState, error) {
 	if !ok {
 		return nil, ErrGameNotFound
 	}
-	return game, nil
+	return cloneGame(game), nil
 }
 
 // List of games within the GameStore
@@ -118,6 +172,13 @@ func (s *MemoryGameStore) List(filter GameFilter) ([]*models.GameState, error) {
 		if filter.Status != nil && g.Status != *filter.Status {
 			continue
 		}
+		if filter.InviteCode != "" {
+			if g.InviteCode != filter.InviteCode {
+				continue
+			}
+		} else if g.Private {
+			continue
+		}
 		result = append(result, g)
 	}
 
@@ -135,5 +196,193 @@ func (s *MemoryGameStore) List(filter GameFilter) ([]*models.GameState, error) {
 		end = start + filter.Limit
 	}
 
-	return result[start:end], nil
+	games := make([]*models.GameState, 0, end-start)
+	for _, g := range result[start:end] {
+		games = append(games, cloneGame(g))
+	}
+	return games, nil
+}
+
+// cloneGame returns a deep copy of the game. Moves in the history are
+// copied by value; their From cells are never modified once recorded.
+func cloneGame(g *models.GameState) *models.GameState {
+	c := *g
+	if g.Board != nil {
+		c.Board = make(models.Board, len(g.Board))
+		for i, row := range g.Board {
+			c.Board[i] = append([]models.Symbol(nil), row...)
+		}
+	}
+	if g.Roles != nil {
+		c.Roles = make(map[models.Symbol]models.Role, len(g.Roles))
+		for seat, role := range g.Roles {
+			c.Roles[seat] = role
+		}
+	}
+	c.PositionHistory = append([]string(nil), g.PositionHistory...)
+	if g.Revealed != nil {
+		c.Revealed = make(map[models.Symbol][][2]int, len(g.Revealed))
+		for seat, cells := range g.Revealed {
+			c.Revealed[seat] = append([][2]int(nil), cells...)
+		}
+	}
+	if g.HintsUsed != nil {
+		c.HintsUsed = make(map[models.Symbol]int, len(g.HintsUsed))
+		for seat, n := range g.HintsUsed {
+			c.HintsUsed[seat] = n
+		}
+	}
+	c.History = append([]models.MoveRecord(nil), g.History...)
+	if g.Personality != nil {
+		p := *g.Personality
+		c.Personality = &p
+	}
+	if g.AIStrategies != nil {
+		c.AIStrategies = make(map[models.Symbol]models.AIStrategy, len(g.AIStrategies))
+		for seat, strategy := range g.AIStrategies {
+			c.AIStrategies[seat] = strategy
+		}
+	}
+	if g.TimeControl != nil {
+		tc := *g.TimeControl
+		c.TimeControl = &tc
+	}
+	if g.Clocks != nil {
+		c.Clocks = make(map[models.Symbol]time.Duration, len(g.Clocks))
+		for seat, left := range g.Clocks {
+			c.Clocks[seat] = left
+		}
+	}
+	if g.Difficulty != nil {
+		d := *g.Difficulty
+		c.Difficulty = &d
+	}
+	return &c
+}
+
+// MemoryChallengeStore is an in-memory implementation of ChallengeStore.
+// It is safe for concurrent use.
+type MemoryChallengeStore struct {
+	mu         sync.RWMutex
+	challenges map[string]*models.Challenge
+}
+
+// NewMemoryChallengeStore constructs a new empty MemoryChallengeStore.
+func NewMemoryChallengeStore() *MemoryChallengeStore {
+	return &MemoryChallengeStore{
+		challenges: make(map[string]*models.Challenge),
+	}
+}
+
+func (s *MemoryChallengeStore) Create(challenge *models.Challenge) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.challenges[challenge.ID] = challenge
+	return nil
+}
+
+func (s *MemoryChallengeStore) Update(challenge *models.Challenge) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Only update if it already exists.
+	if _, ok := s.challenges[challenge.ID]; !ok {
+		return ErrChallengeNotFound
+	}
+	s.challenges[challenge.ID] = challenge
+	return nil
+}
+
+func (s *MemoryChallengeStore) Get(id string) (*models.Challenge, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	challenge, ok := s.challenges[id]
+	if !ok {
+		return nil, ErrChallengeNotFound
+	}
+	return challenge, nil
+}
+
+// List returns the matching challenges, oldest first
+func (s *MemoryChallengeStore) List(filter ChallengeFilter) ([]*models.Challenge, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := []*models.Challenge{}
+	for _, c := range s.challenges {
+		if filter.PlayerID != "" && c.ChallengerID != filter.PlayerID && c.TargetID != filter.PlayerID {
+			continue
+		}
+		if filter.Status != nil && c.Status != *filter.Status {
+			continue
+		}
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+// MemoryNotificationStore is an in-memory implementation of NotificationStore.
+// It is safe for concurrent use.
+type MemoryNotificationStore struct {
+	mu            sync.RWMutex
+	notifications map[string]*models.Notification
+}
+
+// NewMemoryNotificationStore constructs a new empty MemoryNotificationStore.
+func NewMemoryNotificationStore() *MemoryNotificationStore {
+	return &MemoryNotificationStore{
+		notifications: make(map[string]*models.Notification),
+	}
+}
+
+func (s *MemoryNotificationStore) Create(notification *models.Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.notifications[notification.ID] = notification
+	return nil
+}
+
+func (s *MemoryNotificationStore) Update(notification *models.Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Only update if it already exists.
+	if _, ok := s.notifications[notification.ID]; !ok {
+		return ErrNotificationNotFound
+	}
+	s.notifications[notification.ID] = notification
+	return nil
+}
+
+// List returns the matching notifications, newest first
+func (s *MemoryNotificationStore) List(filter NotificationFilter) ([]*models.Notification, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := []*models.Notification{}
+	for _, n := range s.notifications {
+		if filter.PlayerID != "" && n.PlayerID != filter.PlayerID {
+			continue
+		}
+		if filter.UnreadOnly && n.Read {
+			continue
+		}
+		if filter.GameID != "" && n.GameID != filter.GameID {
+			continue
+		}
+		if filter.Type != "" && n.Type != filter.Type {
+			continue
+		}
+		result = append(result, n)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	return result, nil
 }
@@ -27,8 +27,11 @@ import (
 type GameFilter struct {
 	Mode   *models.GameMode
 	Status *models.GameStatus
-	Limit  int
-	Offset int
+	// InviteCode selects the private game with this code; private games are
+	// never listed otherwise
+	InviteCode string
+	Limit      int
+	Offset     int
 }
 
 // GameStore defines how games are presisted and queried
@@ -43,11 +46,49 @@ type GameStore interface {
 // PlayerStore defines how players are persisted and looked up in the internal store
 type PlayerStore interface {
 	Create(player *models.Player) error
+	Update(player *models.Player) error
+	// UpdateFriends and UpdateDifficulty change a single field of a stored
+	// player, so concurrent updates of different fields do not overwrite each other
+	UpdateFriends(id string, friends []string) error
+	UpdateDifficulty(id string, difficulty *models.Difficulty) error
 	Get(id string) (*models.Player, error)
+	List() ([]*models.Player, error)
+}
+
+// ChallengeFilter describes optional criteria when listing challenges.
+type ChallengeFilter struct {
+	// PlayerID selects challenges the player sent or received
+	PlayerID string
+	Status   *models.ChallengeStatus
+}
+
+// ChallengeStore defines how challenges between players are persisted
+type ChallengeStore interface {
+	Create(challenge *models.Challenge) error
+	Update(challenge *models.Challenge) error
+	Get(id string) (*models.Challenge, error)
+	List(filter ChallengeFilter) ([]*models.Challenge, error)
+}
+
+// NotificationFilter describes optional criteria when listing notifications.
+type NotificationFilter struct {
+	PlayerID   string
+	UnreadOnly bool
+	GameID     string
+	Type       models.NotificationType
+}
+
+// NotificationStore defines how the players' notification inboxes are persisted
+type NotificationStore interface {
+	Create(notification *models.Notification) error
+	Update(notification *models.Notification) error
+	List(filter NotificationFilter) ([]*models.Notification, error)
 }
 
 // Definitions of common errors within the game
 var (
-	ErrGameNotFound   = errors.New("game not found")
-	ErrPlayerNotFound = errors.New("player not found")
+	ErrGameNotFound         = errors.New("game not found")
+	ErrPlayerNotFound       = errors.New("player not found")
+	ErrChallengeNotFound    = errors.New("challenge not found")
+	ErrNotificationNotFound = errors.New("notification not found")
 )
@@ -20,6 +20,7 @@ package store
 
 import (
 	"testing"
+	"time"
 
 	"tic-tac-go/internal/models"
 )
@@ -54,6 +55,24 @@ func TestMemoryPlayerStore_Get_NotFound(t *testing.T) {
 	}
 }
 
+func TestMemoryPlayerStore_Update(t *testing.T) {
+	s := NewMemoryPlayerStore()
+
+	if err := s.Update(&models.Player{ID: "missing"}); err != ErrPlayerNotFound {
+		t.Fatalf("expected ErrPlayerNotFound, got %v", err)
+	}
+
+	_ = s.Create(&models.Player{ID: "player-1", Name: "Alice"})
+	if err := s.Update(&models.Player{ID: "player-1", Name: "Alice", Difficulty: &models.Difficulty{Level: 3}}); err != nil {
+		t.Fatalf("Update() error = %v, want nil", err)
+	}
+
+	got, _ := s.Get("player-1")
+	if got.Difficulty == nil || got.Difficulty.Level != 3 {
+		t.Fatalf("expected stored difficulty level 3, got %+v", got.Difficulty)
+	}
+}
+
 func TestMemoryGameStore_CreateGetUpdate(t *testing.T) {
 	s := NewMemoryGameStore()
 
@@ -134,3 +153,90 @@ func TestMemoryGameStore_List_WithFiltersAndPaging(t *testing.T) {
 		t.Fatalf("expected 1 game with limit=1 offset=1, got %d", len(all))
 	}
 }
+
+func TestMemoryGameStore_List_PrivateGames(t *testing.T) {
+	s := NewMemoryGameStore()
+
+	_ = s.Create(&models.GameState{ID: "public", Mode: models.GameModePVP})
+	_ = s.Create(&models.GameState{ID: "private", Mode: models.GameModePVP, Private: true, InviteCode: "ABC234"})
+
+	games, err := s.List(GameFilter{})
+	if err != nil {
+		t.Fatalf("List() error = %v, want nil", err)
+	}
+	if len(games) != 1 || games[0].ID != "public" {
+		t.Fatalf("expected only the public game, got %#v", games)
+	}
+
+	games, err = s.List(GameFilter{InviteCode: "ABC234"})
+	if err != nil {
+		t.Fatalf("List() by invite code error = %v", err)
+	}
+	if len(games) != 1 || games[0].ID != "private" {
+		t.Fatalf("expected the private game, got %#v", games)
+	}
+}
+
+func TestMemoryChallengeStore_List(t *testing.T) {
+	s := NewMemoryChallengeStore()
+	now := time.Now()
+
+	_ = s.Create(&models.Challenge{ID: "c1", ChallengerID: "p1", TargetID: "p2", Status: models.ChallengeStatusPending, CreatedAt: now})
+	_ = s.Create(&models.Challenge{ID: "c2", ChallengerID: "p3", TargetID: "p1", Status: models.ChallengeStatusPending, CreatedAt: now.Add(time.Second)})
+	_ = s.Create(&models.Challenge{ID: "c3", ChallengerID: "p2", TargetID: "p3", Status: models.ChallengeStatusDeclined, CreatedAt: now})
+
+	pending := models.ChallengeStatusPending
+	challenges, err := s.List(ChallengeFilter{PlayerID: "p1", Status: &pending})
+	if err != nil {
+		t.Fatalf("List() error = %v, want nil", err)
+	}
+	if len(challenges) != 2 || challenges[0].ID != "c1" || challenges[1].ID != "c2" {
+		t.Fatalf("expected [c1 c2], got %#v", challenges)
+	}
+
+	if err := s.Update(&models.Challenge{ID: "missing"}); err != ErrChallengeNotFound {
+		t.Fatalf("Update() of unknown challenge: expected ErrChallengeNotFound, got %v", err)
+	}
+}
+
+func TestMemoryGameStore_ReturnsCopies(t *testing.T) {
+	s := NewMemoryGameStore()
+
+	g := &models.GameState{
+		ID:        "g1",
+		Board:     models.Board{{models.SymbolEmpty}},
+		HintsUsed: map[models.Symbol]int{models.SymbolX: 1},
+	}
+	_ = s.Create(g)
+	// Changing the caller's game after Create does not reach the store.
+	g.Board[0][0] = models.SymbolX
+
+	got, _ := s.Get("g1")
+	got.HintsUsed[models.SymbolX]++
+	got.Status = models.GameStatusFinished
+
+	again, _ := s.Get("g1")
+	if again.Board[0][0] != models.SymbolEmpty || again.HintsUsed[models.SymbolX] != 1 || again.Status != "" {
+		t.Fatalf("expected the stored game to be unchanged, got %+v", again)
+	}
+}
+
+func TestMemoryPlayerStore_FieldUpdates(t *testing.T) {
+	s := NewMemoryPlayerStore()
+	_ = s.Create(&models.Player{ID: "p1", Name: "Alice"})
+
+	if err := s.UpdateFriends("p1", []string{"p2"}); err != nil {
+		t.Fatalf("UpdateFriends() error = %v", err)
+	}
+	if err := s.UpdateDifficulty("p1", &models.Difficulty{Level: 3}); err != nil {
+		t.Fatalf("UpdateDifficulty() error = %v", err)
+	}
+
+	got, _ := s.Get("p1")
+	if len(got.Friends) != 1 || got.Difficulty == nil || got.Difficulty.Level != 3 {
+		t.Fatalf("expected both fields to be kept, got %+v", got)
+	}
+	if err := s.UpdateFriends("missing", nil); err != ErrPlayerNotFound {
+		t.Fatalf("UpdateFriends() of unknown player: expected ErrPlayerNotFound, got %v", err)
+	}
+}
@@ -21,6 +21,7 @@ package ws
 import (
 	"log"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -56,6 +57,12 @@ type Connection struct {
 	conn   *websocket.Conn
 	send   chan []byte
 	gameID string
+	// playerID identifies the viewer; empty for anonymous spectators
+	playerID string
+	// done is closed once the hub dropped the connection; send is never
+	// closed, as broadcasts may still be queueing on it
+	done      chan struct{}
+	closeOnce sync.Once
 }
 
 // NewConnection creates a new WebSocket connection wrapper.
@@ -64,9 +71,35 @@ func NewConnection(hub *Hub, conn *websocket.Conn) *Connection {
 		hub:  hub,
 		conn: conn,
 		send: make(chan []byte, 256),
+		done: make(chan struct{}),
 	}
 }
 
+// NewPlayerConnection creates a new WebSocket connection wrapper for a known player,
+// so that hidden-information variants can send that player's own view of the board.
+func NewPlayerConnection(hub *Hub, conn *websocket.Conn, playerID string) *Connection {
+	c := NewConnection(hub, conn)
+	c.playerID = playerID
+	return c
+}
+
+// queue hands a message to WritePump without blocking. The message is
+// dropped if the send buffer is full or the connection was unregistered
+// after the caller copied the hub's connection set.
+func (c *Connection) queue(msg []byte) {
+	select {
+	case <-c.done:
+	case c.send <- msg:
+	default:
+		// Send buffer full, skip
+	}
+}
+
+// close tells WritePump to stop. It is safe to call more than once.
+func (c *Connection) close() {
+	c.closeOnce.Do(func() { close(c.done) })
+}
+
 // ReadPump pumps messages from the WebSocket connection to the hub.
 // The application runs ReadPump in a per-connection goroutine.
 func (c *Connection) ReadPump() {
@@ -106,13 +139,14 @@ func (c *Connection) WritePump() {
 
 	for {
 		select {
-		case message, ok := <-c.send:
+		case <-c.done:
+			// Hub dropped the connection
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+			return
+
+		case message := <-c.send:
 			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
-			if !ok {
-				// Hub closed the channel
-				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
-				return
-			}
 
 			w, err := c.conn.NextWriter(websocket.TextMessage)
 			if err != nil {
@@ -21,15 +21,19 @@ package ws
 import (
 	"encoding/json"
 	"sync"
+	"time"
 
+	"tic-tac-go/internal/game"
 	"tic-tac-go/internal/models"
 )
 
-// Hub manages WebSocket connections grouped by game ID.
-// It is safe for concurrent use.
+// Hub manages WebSocket connections grouped by game ID, plus per-player
+// channels that are not tied to a game. It is safe for concurrent use.
 type Hub struct {
 	// clients maps gameID -> set of connections for that game
 	clients map[string]map[*Connection]struct{}
+	// players maps playerID -> set of that player's own channel connections
+	players map[string]map[*Connection]struct{}
 	mu      sync.RWMutex
 
 	// register channel for new connections
@@ -37,12 +41,16 @@ type Hub struct {
 
 	// unregister channel for disconnected clients
 	unregister chan *Connection
+
+	// onPresence is called after a known player connected or disconnected
+	onPresence func(playerID string)
 }
 
 // NewHub creates a new WebSocket hub.
 func NewHub() *Hub {
 	return &Hub{
 		clients:    make(map[string]map[*Connection]struct{}),
+		players:    make(map[string]map[*Connection]struct{}),
 		register:   make(chan *Connection),
 		unregister: make(chan *Connection),
 	}
@@ -54,24 +62,71 @@ func (h *Hub) Run() {
 		select {
 		case conn := <-h.register:
 			h.mu.Lock()
-			if h.clients[conn.gameID] == nil {
-				h.clients[conn.gameID] = make(map[*Connection]struct{})
+			groups, key := h.group(conn)
+			if groups[key] == nil {
+				groups[key] = make(map[*Connection]struct{})
 			}
-			h.clients[conn.gameID][conn] = struct{}{}
+			groups[key][conn] = struct{}{}
+			onPresence := h.onPresence
 			h.mu.Unlock()
+			if onPresence != nil && conn.playerID != "" {
+				onPresence(conn.playerID)
+			}
 
 		case conn := <-h.unregister:
 			h.mu.Lock()
-			if clients, ok := h.clients[conn.gameID]; ok {
+			groups, key := h.group(conn)
+			if clients, ok := groups[key]; ok {
 				delete(clients, conn)
 				if len(clients) == 0 {
-					delete(h.clients, conn.gameID)
+					delete(groups, key)
 				}
 			}
-			close(conn.send)
+			conn.close()
+			onPresence := h.onPresence
 			h.mu.Unlock()
+			if onPresence != nil && conn.playerID != "" {
+				onPresence(conn.playerID)
+			}
+		}
+	}
+}
+
+// group returns the connection set a connection belongs to and its key:
+// game connections are grouped by game, player channels by player.
+func (h *Hub) group(conn *Connection) (map[string]map[*Connection]struct{}, string) {
+	if conn.gameID == "" {
+		return h.players, conn.playerID
+	}
+	return h.clients, conn.gameID
+}
+
+// SetPresenceHandler registers fn to be called whenever a connection of a
+// known player opens or closes, e.g. to tell the player's friends.
+func (h *Hub) SetPresenceHandler(fn func(playerID string)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onPresence = fn
+}
+
+// Presence reports whether the player has any open connection and which
+// games they are connected to.
+func (h *Hub) Presence(playerID string) (bool, []string) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	online := len(h.players[playerID]) > 0
+	var gameIDs []string
+	for gameID, clients := range h.clients {
+		for conn := range clients {
+			if conn.playerID == playerID {
+				online = true
+				gameIDs = append(gameIDs, gameID)
+				break
+			}
 		}
 	}
+	return online, gameIDs
 }
 
 // Register adds a connection to the hub for a specific game.
@@ -80,12 +135,20 @@ func (h *Hub) Register(gameID string, conn *Connection) {
 	h.register <- conn
 }
 
+// RegisterPlayer adds a player connection to the hub as that player's own
+// channel, which receives events such as incoming challenges.
+func (h *Hub) RegisterPlayer(conn *Connection) {
+	conn.gameID = ""
+	h.register <- conn
+}
+
 // Unregister removes a connection from the hub.
 func (h *Hub) Unregister(conn *Connection) {
 	h.unregister <- conn
 }
 
 // BroadcastGameState sends the game state to all connections registered for the given gameID.
+// For a running fog-of-war game every connection receives the board as seen by its own player.
 func (h *Hub) BroadcastGameState(gameID string, state *models.GameState) {
 	h.mu.RLock()
 	clients, ok := h.clients[gameID]
@@ -94,48 +157,92 @@ func (h *Hub) BroadcastGameState(gameID string, state *models.GameState) {
 		return
 	}
 
-	// Convert board to [][]string for JSON
-	board := make([][]string, 3)
-	for i := 0; i < 3; i++ {
-		board[i] = make([]string, 3)
-		for j := 0; j < 3; j++ {
-			board[i][j] = string(state.Board[i][j])
+	// Copy clients slice to avoid holding lock while sending
+	conns := make([]*Connection, 0, len(clients))
+	for conn := range clients {
+		conns = append(conns, conn)
+	}
+	h.mu.RUnlock()
+
+	// Messages are cached per viewer symbol; in non-hidden games every
+	// connection shares the same message.
+	messages := make(map[models.Symbol][]byte)
+
+	// Send to all connections (non-blocking)
+	for _, conn := range conns {
+		viewer := game.SymbolForPlayer(state, conn.playerID)
+		msgBytes, ok := messages[viewer]
+		if !ok {
+			var err error
+			msgBytes, err = stateMessage(state, game.BoardForPlayer(state, conn.playerID))
+			if err != nil {
+				return
+			}
+			messages[viewer] = msgBytes
 		}
+
+		conn.queue(msgBytes)
 	}
+}
 
-	message := map[string]interface{}{
-		"type": "state",
+// BroadcastAIThinking tells all connections of a game that the AI in seat
+// is choosing its move.
+func (h *Hub) BroadcastAIThinking(gameID string, seat models.Symbol) {
+	msg := map[string]interface{}{
+		"type": "ai_thinking",
 		"payload": map[string]interface{}{
-			"gameId":      state.ID,
-			"board":       board,
-			"currentTurn": string(state.CurrentTurn),
-			"status":      string(state.Status),
-			"winner":      state.Winner,
+			"gameId": gameID,
+			"seat":   string(seat),
 		},
 	}
 
-	msgBytes, err := json.Marshal(message)
+	msgBytes, err := json.Marshal(msg)
 	if err != nil {
-		h.mu.RUnlock()
 		return
 	}
 
-	// Copy clients slice to avoid holding lock while sending
-	conns := make([]*Connection, 0, len(clients))
-	for conn := range clients {
-		conns = append(conns, conn)
+	// Send outside the lock, as BroadcastGameState does.
+	for _, conn := range h.connections(h.clients, gameID) {
+		conn.queue(msgBytes)
 	}
-	h.mu.RUnlock()
+}
 
-	// Send to all connections (non-blocking)
-	for _, conn := range conns {
-		select {
-		case conn.send <- msgBytes:
-		default:
-			// If send buffer is full, skip this connection
-			// (it will be cleaned up on next unregister)
+// stateMessage serialises a "state" message for the given game using the provided board.
+func stateMessage(state *models.GameState, visible models.Board) ([]byte, error) {
+	// Convert board to [][]string for JSON (blocked cells are sent as "#")
+	board := make([][]string, len(visible))
+	for i := range visible {
+		board[i] = make([]string, len(visible[i]))
+		for j := range visible[i] {
+			board[i][j] = string(visible[i][j])
+		}
+	}
+
+	payload := map[string]interface{}{
+		"gameId":      state.ID,
+		"variant":     string(state.Variant),
+		"torus":       state.Torus,
+		"board":       board,
+		"currentTurn": string(state.CurrentTurn),
+		"status":      string(state.Status),
+		"winner":      state.Winner,
+		"forfeitedBy": string(state.ForfeitedBy),
+		"roles":       state.Roles,
+	}
+	if state.Clocks != nil {
+		now := time.Now()
+		clocks := make(map[string]int64, len(state.Clocks))
+		for seat := range state.Clocks {
+			left, _ := game.RemainingTime(state, seat, now)
+			clocks[string(seat)] = left.Milliseconds()
 		}
+		payload["clocksMs"] = clocks
 	}
+
+	return json.Marshal(map[string]interface{}{
+		"type":    "state",
+		"payload": payload,
+	})
 }
 
 // BroadcastError sends an error message to a specific connection.
@@ -151,10 +258,92 @@ func (h *Hub) BroadcastError(conn *Connection, message string) {
 	if err != nil {
 		return
 	}
+	conn.queue(msgBytes)
+}
+
+// BroadcastChallenge sends the current state of a challenge to the player's
+// own channel.
+func (h *Hub) BroadcastChallenge(playerID string, challenge *models.Challenge) {
+	payload := map[string]interface{}{
+		"challengeId":  challenge.ID,
+		"challengerId": challenge.ChallengerID,
+		"targetId":     challenge.TargetID,
+		"mode":         string(challenge.Mode),
+		"variant":      string(challenge.Variant),
+		"status":       string(challenge.Status),
+		"gameId":       challenge.GameID,
+		"expiresAt":    challenge.ExpiresAt.Format(time.RFC3339),
+	}
+	if tc := challenge.TimeControl; tc != nil {
+		payload["timeControl"] = map[string]interface{}{
+			"initialSeconds":   int64(tc.Initial / time.Second),
+			"incrementSeconds": int64(tc.Increment / time.Second),
+		}
+	}
+
+	msgBytes, err := json.Marshal(map[string]interface{}{
+		"type":    "challenge",
+		"payload": payload,
+	})
+	if err != nil {
+		return
+	}
+	h.sendToPlayer(playerID, msgBytes)
+}
 
-	select {
-	case conn.send <- msgBytes:
-	default:
-		// Send buffer full, skip
+// BroadcastPresence sends a friend's presence to the player's own channel.
+func (h *Hub) BroadcastPresence(playerID string, presence *models.Presence) {
+	msgBytes, err := json.Marshal(map[string]interface{}{
+		"type": "presence",
+		"payload": map[string]interface{}{
+			"playerId": presence.PlayerID,
+			"name":     presence.Name,
+			"status":   string(presence.Status),
+			"gameId":   presence.GameID,
+		},
+	})
+	if err != nil {
+		return
+	}
+	h.sendToPlayer(playerID, msgBytes)
+}
+
+// BroadcastNotification sends a new inbox entry to the player's own channel.
+func (h *Hub) BroadcastNotification(playerID string, notification *models.Notification) {
+	msgBytes, err := json.Marshal(map[string]interface{}{
+		"type": "notification",
+		"payload": map[string]interface{}{
+			"notificationId": notification.ID,
+			"type":           string(notification.Type),
+			"gameId":         notification.GameID,
+			"challengeId":    notification.ChallengeID,
+			"message":        notification.Message,
+			"read":           notification.Read,
+			"createdAt":      notification.CreatedAt.Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		return
+	}
+	h.sendToPlayer(playerID, msgBytes)
+}
+
+// sendToPlayer queues a message on all channel connections of a player.
+func (h *Hub) sendToPlayer(playerID string, msgBytes []byte) {
+	for _, conn := range h.connections(h.players, playerID) {
+		conn.queue(msgBytes)
+	}
+}
+
+// connections copies the connection set groups[key], so messages can be
+// sent without holding the lock.
+func (h *Hub) connections(groups map[string]map[*Connection]struct{}, key string) []*Connection {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	conns := make([]*Connection, 0, len(groups[key]))
+	for conn := range groups[key] {
+		conns = append(conns, conn)
 	}
+	return conns
 }